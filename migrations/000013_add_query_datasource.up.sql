@@ -0,0 +1,2 @@
+ALTER TABLE queries
+ADD COLUMN datasource_id varchar(255) default '';
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS jobs (
+  id uuid NOT NULL,
+  query_id uuid NOT NULL,
+  report_id uuid NOT NULL,
+  datasource_id varchar(255) DEFAULT '',
+  job_status int DEFAULT 0,
+  external_id text DEFAULT '',
+  instance_id uuid,
+  heartbeat_at timestamptz DEFAULT CURRENT_TIMESTAMP,
+  updated_at timestamptz DEFAULT CURRENT_TIMESTAMP,
+  created_at timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(id)
+);
+
+CREATE INDEX IF NOT EXISTS jobs_heartbeat_at_idx ON jobs (heartbeat_at);
+CREATE INDEX IF NOT EXISTS jobs_instance_id_idx ON jobs (instance_id);
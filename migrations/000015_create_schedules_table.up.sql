@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS schedules (
+  id uuid NOT NULL,
+  report_id uuid NOT NULL,
+  query_id uuid,
+  cron text NOT NULL,
+  enabled boolean DEFAULT true,
+  author_email varchar(255) NOT NULL,
+  next_run_at timestamptz,
+  last_run_at timestamptz,
+  last_error text DEFAULT '',
+  created_at timestamptz DEFAULT CURRENT_TIMESTAMP,
+  updated_at timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(id)
+);
+
+CREATE INDEX IF NOT EXISTS schedules_report_id_idx ON schedules (report_id);
+CREATE INDEX IF NOT EXISTS schedules_next_run_at_idx ON schedules (next_run_at) WHERE enabled;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS report_revisions (
+  id uuid NOT NULL,
+  report_id uuid NOT NULL,
+  revision int NOT NULL,
+  title text,
+  map_config text,
+  query_source_ids jsonb DEFAULT '{}',
+  author_email varchar(255),
+  created_at timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(id),
+  UNIQUE(report_id, revision)
+);
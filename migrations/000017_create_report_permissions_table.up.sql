@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS report_permissions (
+  report_id uuid NOT NULL,
+  grantee varchar(255) NOT NULL, -- email or @domain
+  role int NOT NULL, -- 1 viewer, 2 editor
+  granted_by varchar(255),
+  created_at timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(report_id, grantee)
+);
+
+-- role of user in report: 0 none, 1 viewer, 2 editor, 3 author
+CREATE OR REPLACE FUNCTION report_role(uuid, varchar) RETURNS int AS $$
+  SELECT CASE
+    WHEN EXISTS (SELECT 1 FROM reports WHERE id = $1 AND author_email = $2) THEN 3
+    ELSE coalesce((
+      SELECT max(role) FROM report_permissions
+      WHERE report_id = $1 AND grantee IN (lower($2), '@' || lower(split_part($2, '@', 2)))
+    ), 0)
+  END
+$$ LANGUAGE sql STABLE;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS api_tokens (
+  id uuid NOT NULL,
+  user_email varchar(255) NOT NULL,
+  name varchar(255) DEFAULT '',
+  token_hash varchar(64) NOT NULL,
+  token_prefix varchar(32) DEFAULT '',
+  last_used_at timestamptz,
+  revoked_at timestamptz,
+  created_at timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(id),
+  UNIQUE(token_hash)
+);
+
+CREATE INDEX IF NOT EXISTS api_tokens_user_email_idx ON api_tokens (user_email);
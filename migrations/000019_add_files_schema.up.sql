@@ -0,0 +1,2 @@
+ALTER TABLE files ADD COLUMN IF NOT EXISTS row_count bigint DEFAULT 0;
+ALTER TABLE files ADD COLUMN IF NOT EXISTS schema jsonb DEFAULT '[]';
@@ -0,0 +1,5 @@
+ALTER TABLE files ADD COLUMN IF NOT EXISTS upload_id varchar(1024);
+ALTER TABLE files ADD COLUMN IF NOT EXISTS upload_object varchar(255);
+ALTER TABLE files ADD COLUMN IF NOT EXISTS chunk_size bigint DEFAULT 0;
+ALTER TABLE files ADD COLUMN IF NOT EXISTS chunk_count integer DEFAULT 0;
+ALTER TABLE files ADD COLUMN IF NOT EXISTS uploaded_chunks integer[] DEFAULT '{}';
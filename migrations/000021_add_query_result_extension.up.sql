@@ -0,0 +1 @@
+ALTER TABLE queries ADD COLUMN IF NOT EXISTS job_result_extension varchar(16) DEFAULT 'csv';
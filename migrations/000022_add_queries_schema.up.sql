@@ -0,0 +1 @@
+ALTER TABLE queries ADD COLUMN IF NOT EXISTS schema jsonb DEFAULT '[]';
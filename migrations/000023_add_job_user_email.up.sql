@@ -0,0 +1,5 @@
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS user_email varchar(255) DEFAULT '';
+ALTER TABLE queries ADD COLUMN IF NOT EXISTS job_user_email varchar(255) DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS jobs_user_email_idx ON jobs (user_email);
+CREATE INDEX IF NOT EXISTS queries_job_user_email_idx ON queries (job_user_email, job_started);
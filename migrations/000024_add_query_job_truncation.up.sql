@@ -0,0 +1 @@
+ALTER TABLE queries ADD COLUMN IF NOT EXISTS job_truncation text DEFAULT '';
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS report_parameters (
+  report_id uuid NOT NULL,
+  name varchar(255) NOT NULL, -- referenced in query text as {{name}}
+  parameter_type int NOT NULL, -- 1 string, 2 number, 3 date, 4 boolean
+  default_value text DEFAULT '',
+  created_at timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(report_id, name)
+);
+
+-- hash of parameter values of the last run, results are reused from cache only for the same values
+ALTER TABLE queries ADD COLUMN IF NOT EXISTS job_parameters_hash varchar(40) DEFAULT '';
@@ -0,0 +1,6 @@
+-- reports are readable by anyone with link when link sharing is on
+ALTER TABLE reports ADD COLUMN IF NOT EXISTS link_sharing boolean DEFAULT true;
+
+-- reports shared with users or domains were not readable by link before
+UPDATE reports SET link_sharing = false
+WHERE EXISTS (SELECT 1 FROM report_permissions WHERE report_id = reports.id);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS job_usage (
+  job_id uuid NOT NULL,
+  user_email varchar(255) NOT NULL,
+  usage_date date DEFAULT CURRENT_DATE,
+  bytes_processed bigint DEFAULT 0,
+  created_at timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(job_id)
+);
+
+CREATE INDEX IF NOT EXISTS job_usage_user_email_usage_date_idx ON job_usage (user_email, usage_date);
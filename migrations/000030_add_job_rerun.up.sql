@@ -0,0 +1,2 @@
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS rerun boolean DEFAULT false;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS run_options text DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE queries ADD COLUMN IF NOT EXISTS job_user_scoped boolean DEFAULT false;
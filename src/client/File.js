@@ -7,22 +7,31 @@ import prettyBites from 'pretty-bytes'
 import { useSelector, useDispatch } from 'react-redux'
 import { uploadFile } from './actions'
 
-function getFileExtensionName (type) {
+function getFileExtensionName (type, name) {
   switch (type) {
     case 'application/geo+json':
       return 'geojson'
     case 'text/csv':
       return 'csv'
-    default:
+    case 'application/vnd.apache.parquet':
+    case 'application/x-parquet':
+      return 'parquet'
+    default: {
+      // browsers do not know mime types of most geo formats
+      const extension = name ? name.split('.').pop().toLowerCase() : ''
+      if (['parquet', 'zip', 'kml', 'kmz', 'gpkg'].includes(extension)) {
+        return extension
+      }
       return '???'
+    }
   }
 }
 
-function FileIcon ({ fileInfo: { type } }) {
+function FileIcon ({ fileInfo: { type, name } }) {
   return (
     <div className={styles.fileIcon}>
       <div className={styles.fileIconBody} />
-      <div className={styles.fileIconExtension}>{getFileExtensionName(type)}</div>
+      <div className={styles.fileIconExtension}>{getFileExtensionName(type, name)}</div>
     </div>
   )
 }
@@ -66,7 +75,8 @@ function FileStatus ({ file, fileToUpload, fileUploadStatus, children }) {
     } else if (file.fileStatus === 3) {
       // file stored in permanent storage
       icon = <CheckCircleTwoTone className={styles.icon} twoToneColor='#52c41a' />
-      message = <span>Ready <span className={styles.processed}>({prettyBites(file.size)})</span></span>
+      const rowCount = file.rowCount ? `, ${file.rowCount} rows` : ''
+      message = <span>Ready <span className={styles.processed}>({prettyBites(file.size)}{rowCount})</span></span>
       style = styles.success
     }
   } else if (fileToUpload) {
@@ -136,7 +146,7 @@ export default function File ({ file }) {
             <div className={styles.upload}>
               <Upload
                 maxCount={1}
-                accept='.csv,.geojson'
+                accept='.csv,.geojson,.parquet,.zip,.kml,.kmz,.gpkg'
                 fileList={[]}
                 beforeUpload={(file) => {
                   setFileToUpload(file)
@@ -145,7 +155,7 @@ export default function File ({ file }) {
               >
                 <div className={styles.uploadIcon}><InboxOutlined /></div>
                 <div className={styles.uploadHeader}>Click or drag file to this area to upload</div>
-                <div className={styles.uploadSubtitle}>Supported format: .csv .geojson</div>
+                <div className={styles.uploadSubtitle}>Supported format: .csv .geojson .parquet .kml .kmz .gpkg, zipped shapefile</div>
               </Upload>
             </div>
             )}
@@ -15,6 +15,7 @@ import { SendOutlined, CheckCircleTwoTone, ExclamationCircleTwoTone, ClockCircle
 import { Duration } from 'luxon'
 import prettyBites from 'pretty-bytes'
 import DataDocumentationLink from './DataDocumentationLink'
+import { apiUrl } from './lib/api'
 
 function CancelButton ({ query }) {
   const dispatch = useDispatch()
@@ -43,6 +44,22 @@ function ShowDataTable ({ query }) {
   )
 }
 
+function DownloadParquet ({ query }) {
+  const { downloadingResults } = useSelector(state => state.queryStatus[query.id])
+  if (downloadingResults || !query.jobResultId) {
+    return null
+  }
+  return (
+    <Button
+      size='small'
+      type='ghost'
+      className={styles.button}
+      href={apiUrl(`/query-result/${query.jobResultId}.parquet`)}
+    >Parquet
+    </Button>
+  )
+}
+
 function JobTimer ({ query }) {
   const online = useSelector(state => state.reportStatus.online)
   const lastUpdated = useSelector(state => state.reportStatus.lastUpdated)
@@ -158,7 +175,7 @@ function QueryStatus ({ children, query }) {
       icon = <CheckCircleTwoTone className={styles.icon} twoToneColor='#52c41a' />
       message = <span>Ready <Processed query={query} /></span>
       style = styles.success
-      action = <ShowDataTable query={query} />
+      action = <><ShowDataTable query={query} /><DownloadParquet query={query} /></>
       break
     default:
   }
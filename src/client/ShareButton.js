@@ -27,7 +27,8 @@ function CopyLinkButton () {
 function AuthTypeTitle ({ authType, referer }) {
   const { anchor, title } = {
     AMAZON_OIDC: { anchor: 'user-authorization-via-amazon-load-balancer', title: 'Amazon OIDC' },
-    IAP: { anchor: 'user-authorization-via-google-iap', title: 'Google IAP' }
+    IAP: { anchor: 'user-authorization-via-google-iap', title: 'Google IAP' },
+    OIDC: { anchor: 'user-authorization-via-oidc', title: 'OIDC' }
   }[authType]
   return (
     <><span>Users authorized via </span>
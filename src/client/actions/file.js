@@ -21,25 +21,70 @@ export function uploadFileStateChange (fileId, readyState, status) {
   }
 }
 
+const chunkRetries = 3
+
+// sendChunk uploads one chunk, resolves with response status
+function sendChunk (url, chunk, onProgress) {
+  return new Promise((resolve) => {
+    const request = new window.XMLHttpRequest()
+    request.upload.addEventListener('progress', (event) => onProgress(event.loaded))
+    request.addEventListener('loadend', () => resolve(request.status))
+    request.open('PUT', url)
+    request.timeout = 600 * 1000 // 10 minutes
+    request.send(chunk)
+  })
+}
+
+// uploadFile in chunks; upload interrupted by reload or network error is resumed when the same file is selected again
 export function uploadFile (fileId, file) {
   return async (dispatch) => {
     dispatch({ type: uploadFile.name, fileId, file })
-    const formData = new window.FormData()
-    formData.append('file', file)
     const { REACT_APP_API_HOST } = process.env
     const host = REACT_APP_API_HOST || ''
-    const url = `${host}/api/v1/file/${fileId}.csv`
-    const request = new window.XMLHttpRequest()
-    request.upload.addEventListener('progress', (event) => {
-      dispatch(uploadFileProgress(fileId, event.loaded, event.total))
-    })
-    request.addEventListener('readystatechange', (event) => {
-      dispatch(uploadFileStateChange(fileId, request.readyState, request.status))
-    })
-    request.open('POST', url)
-    request.timeout = 3600 * 1000 // 1 hour
-    request.multipart = true
-    request.send(formData)
+    const url = `${host}/api/v1/file/${fileId}/upload`
+    let res
+    try {
+      res = await window.fetch(url, {
+        method: 'POST',
+        headers: { 'Content-Type': 'application/json' },
+        body: JSON.stringify({ name: file.name, size: file.size, mimeType: file.type })
+      })
+    } catch (err) {
+      dispatch(uploadFileStateChange(fileId, 4, 0))
+      return
+    }
+    if (!res.ok) {
+      dispatch(uploadFileStateChange(fileId, 4, res.status))
+      return
+    }
+    const { chunkSize, chunkCount, uploadedChunks } = await res.json()
+    const uploaded = new Set(uploadedChunks)
+    let loaded = 0
+    for (let i = 0; i < chunkCount; i++) {
+      const chunk = file.slice(i * chunkSize, (i + 1) * chunkSize)
+      if (uploaded.has(i)) {
+        loaded += chunk.size
+        dispatch(uploadFileProgress(fileId, loaded, file.size))
+        continue
+      }
+      let status = 0
+      for (let attempt = 0; attempt < chunkRetries && status !== 200; attempt++) {
+        status = await sendChunk(`${url}/${i}`, chunk, (chunkLoaded) => {
+          dispatch(uploadFileProgress(fileId, loaded + chunkLoaded, file.size))
+        })
+      }
+      if (status !== 200) {
+        dispatch(uploadFileStateChange(fileId, 4, status))
+        return
+      }
+      loaded += chunk.size
+    }
+    try {
+      res = await window.fetch(`${url}/complete`, { method: 'POST' })
+      dispatch(uploadFileStateChange(fileId, 4, res.status))
+    } catch (err) {
+      dispatch(uploadFileStateChange(fileId, 4, 0))
+    }
   }
 }
 
@@ -1,12 +1,15 @@
+export function apiUrl (endpoint) {
+  const { REACT_APP_API_HOST } = process.env
+  const host = REACT_APP_API_HOST || ''
+  return `${host}/api/v1${endpoint}`
+}
+
 export async function call (method, endpoint, body) {
   const headers = {}
   if (body) {
     headers['Content-Type'] = 'application/json; charset=utf-8'
   }
-  const { REACT_APP_API_HOST } = process.env
-  const host = REACT_APP_API_HOST || ''
-
-  const url = `${host}/api/v1${endpoint}`
+  const url = apiUrl(endpoint)
   const res = await window.fetch(
     url,
     {
@@ -11,6 +11,11 @@ export function getDatasourceMeta (datasource) {
         name: 'BigQuery',
         usageStatsId: 2
       }
+    case 'PG':
+      return {
+        name: 'PostgreSQL',
+        usageStatsId: 4
+      }
     case 'ATHENA':
       return {
         name: 'Athena',
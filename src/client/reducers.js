@@ -259,8 +259,8 @@ function env (state = defaultEnv, action) {
       return {
         loaded: true,
         variables: action.variables,
-        authEnabled: action.variables.REQUIRE_AMAZON_OIDC === '1' || action.variables.REQUIRE_IAP === '1',
-        authType: action.variables.REQUIRE_IAP === '1' ? 'IAP' : action.variables.REQUIRE_AMAZON_OIDC ? 'AMAZON_OIDC' : 'NONE'
+        authEnabled: action.variables.REQUIRE_AMAZON_OIDC === '1' || action.variables.REQUIRE_IAP === '1' || action.variables.REQUIRE_OIDC === '1',
+        authType: action.variables.REQUIRE_IAP === '1' ? 'IAP' : action.variables.REQUIRE_AMAZON_OIDC === '1' ? 'AMAZON_OIDC' : action.variables.REQUIRE_OIDC === '1' ? 'OIDC' : 'NONE'
       }
     default:
       return state
@@ -20,6 +20,162 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ReportPermission_Role int32
+
+const (
+	ReportPermission_ROLE_UNSPECIFIED ReportPermission_Role = 0
+	ReportPermission_ROLE_VIEWER      ReportPermission_Role = 1
+	ReportPermission_ROLE_EDITOR      ReportPermission_Role = 2
+)
+
+// Enum value maps for ReportPermission_Role.
+var (
+	ReportPermission_Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "ROLE_VIEWER",
+		2: "ROLE_EDITOR",
+	}
+	ReportPermission_Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"ROLE_VIEWER":      1,
+		"ROLE_EDITOR":      2,
+	}
+)
+
+func (x ReportPermission_Role) Enum() *ReportPermission_Role {
+	p := new(ReportPermission_Role)
+	*p = x
+	return p
+}
+
+func (x ReportPermission_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportPermission_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_dekart_proto_enumTypes[0].Descriptor()
+}
+
+func (ReportPermission_Role) Type() protoreflect.EnumType {
+	return &file_proto_dekart_proto_enumTypes[0]
+}
+
+func (x ReportPermission_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportPermission_Role.Descriptor instead.
+func (ReportPermission_Role) EnumDescriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type ReportParameter_Type int32
+
+const (
+	ReportParameter_TYPE_UNSPECIFIED ReportParameter_Type = 0
+	ReportParameter_TYPE_STRING      ReportParameter_Type = 1
+	ReportParameter_TYPE_NUMBER      ReportParameter_Type = 2
+	ReportParameter_TYPE_DATE        ReportParameter_Type = 3 // YYYY-MM-DD
+	ReportParameter_TYPE_BOOLEAN     ReportParameter_Type = 4
+)
+
+// Enum value maps for ReportParameter_Type.
+var (
+	ReportParameter_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_STRING",
+		2: "TYPE_NUMBER",
+		3: "TYPE_DATE",
+		4: "TYPE_BOOLEAN",
+	}
+	ReportParameter_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_STRING":      1,
+		"TYPE_NUMBER":      2,
+		"TYPE_DATE":        3,
+		"TYPE_BOOLEAN":     4,
+	}
+)
+
+func (x ReportParameter_Type) Enum() *ReportParameter_Type {
+	p := new(ReportParameter_Type)
+	*p = x
+	return p
+}
+
+func (x ReportParameter_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportParameter_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_dekart_proto_enumTypes[1].Descriptor()
+}
+
+func (ReportParameter_Type) Type() protoreflect.EnumType {
+	return &file_proto_dekart_proto_enumTypes[1]
+}
+
+func (x ReportParameter_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportParameter_Type.Descriptor instead.
+func (ReportParameter_Type) EnumDescriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type RevisionChange_Kind int32
+
+const (
+	RevisionChange_KIND_UNSPECIFIED RevisionChange_Kind = 0
+	RevisionChange_KIND_ADDED       RevisionChange_Kind = 1
+	RevisionChange_KIND_REMOVED     RevisionChange_Kind = 2
+	RevisionChange_KIND_CHANGED     RevisionChange_Kind = 3
+)
+
+// Enum value maps for RevisionChange_Kind.
+var (
+	RevisionChange_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_ADDED",
+		2: "KIND_REMOVED",
+		3: "KIND_CHANGED",
+	}
+	RevisionChange_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_ADDED":       1,
+		"KIND_REMOVED":     2,
+		"KIND_CHANGED":     3,
+	}
+)
+
+func (x RevisionChange_Kind) Enum() *RevisionChange_Kind {
+	p := new(RevisionChange_Kind)
+	*p = x
+	return p
+}
+
+func (x RevisionChange_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevisionChange_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_dekart_proto_enumTypes[2].Descriptor()
+}
+
+func (RevisionChange_Kind) Type() protoreflect.EnumType {
+	return &file_proto_dekart_proto_enumTypes[2]
+}
+
+func (x RevisionChange_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevisionChange_Kind.Descriptor instead.
+func (RevisionChange_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{16, 0}
+}
+
 type GetEnvResponse_Variable_Type int32
 
 const (
@@ -33,21 +189,23 @@ const (
 	GetEnvResponse_Variable_TYPE_REQUIRE_AMAZON_OIDC   GetEnvResponse_Variable_Type = 7
 	GetEnvResponse_Variable_TYPE_REQUIRE_IAP           GetEnvResponse_Variable_Type = 8
 	GetEnvResponse_Variable_TYPE_DISABLE_USAGE_STATS   GetEnvResponse_Variable_Type = 9
+	GetEnvResponse_Variable_TYPE_REQUIRE_OIDC          GetEnvResponse_Variable_Type = 10
 )
 
 // Enum value maps for GetEnvResponse_Variable_Type.
 var (
 	GetEnvResponse_Variable_Type_name = map[int32]string{
-		0: "TYPE_UNSPECIFIED",
-		1: "TYPE_MAPBOX_TOKEN",
-		2: "TYPE_UX_DATA_DOCUMENTATION",
-		3: "TYPE_UX_HOMEPAGE",
-		4: "TYPE_ALLOW_FILE_UPLOAD",
-		5: "TYPE_DATASOURCE",
-		6: "TYPE_STORAGE",
-		7: "TYPE_REQUIRE_AMAZON_OIDC",
-		8: "TYPE_REQUIRE_IAP",
-		9: "TYPE_DISABLE_USAGE_STATS",
+		0:  "TYPE_UNSPECIFIED",
+		1:  "TYPE_MAPBOX_TOKEN",
+		2:  "TYPE_UX_DATA_DOCUMENTATION",
+		3:  "TYPE_UX_HOMEPAGE",
+		4:  "TYPE_ALLOW_FILE_UPLOAD",
+		5:  "TYPE_DATASOURCE",
+		6:  "TYPE_STORAGE",
+		7:  "TYPE_REQUIRE_AMAZON_OIDC",
+		8:  "TYPE_REQUIRE_IAP",
+		9:  "TYPE_DISABLE_USAGE_STATS",
+		10: "TYPE_REQUIRE_OIDC",
 	}
 	GetEnvResponse_Variable_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":           0,
@@ -60,6 +218,7 @@ var (
 		"TYPE_REQUIRE_AMAZON_OIDC":   7,
 		"TYPE_REQUIRE_IAP":           8,
 		"TYPE_DISABLE_USAGE_STATS":   9,
+		"TYPE_REQUIRE_OIDC":          10,
 	}
 )
 
@@ -74,11 +233,11 @@ func (x GetEnvResponse_Variable_Type) String() string {
 }
 
 func (GetEnvResponse_Variable_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_dekart_proto_enumTypes[0].Descriptor()
+	return file_proto_dekart_proto_enumTypes[3].Descriptor()
 }
 
 func (GetEnvResponse_Variable_Type) Type() protoreflect.EnumType {
-	return &file_proto_dekart_proto_enumTypes[0]
+	return &file_proto_dekart_proto_enumTypes[3]
 }
 
 func (x GetEnvResponse_Variable_Type) Number() protoreflect.EnumNumber {
@@ -87,7 +246,7 @@ func (x GetEnvResponse_Variable_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetEnvResponse_Variable_Type.Descriptor instead.
 func (GetEnvResponse_Variable_Type) EnumDescriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{8, 0, 0}
+	return file_proto_dekart_proto_rawDescGZIP(), []int{44, 0, 0}
 }
 
 type Query_JobStatus int32
@@ -132,11 +291,11 @@ func (x Query_JobStatus) String() string {
 }
 
 func (Query_JobStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_dekart_proto_enumTypes[1].Descriptor()
+	return file_proto_dekart_proto_enumTypes[4].Descriptor()
 }
 
 func (Query_JobStatus) Type() protoreflect.EnumType {
-	return &file_proto_dekart_proto_enumTypes[1]
+	return &file_proto_dekart_proto_enumTypes[4]
 }
 
 func (x Query_JobStatus) Number() protoreflect.EnumNumber {
@@ -145,7 +304,7 @@ func (x Query_JobStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Query_JobStatus.Descriptor instead.
 func (Query_JobStatus) EnumDescriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{15, 0}
+	return file_proto_dekart_proto_rawDescGZIP(), []int{52, 0}
 }
 
 type Query_QuerySource int32
@@ -181,11 +340,11 @@ func (x Query_QuerySource) String() string {
 }
 
 func (Query_QuerySource) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_dekart_proto_enumTypes[2].Descriptor()
+	return file_proto_dekart_proto_enumTypes[5].Descriptor()
 }
 
 func (Query_QuerySource) Type() protoreflect.EnumType {
-	return &file_proto_dekart_proto_enumTypes[2]
+	return &file_proto_dekart_proto_enumTypes[5]
 }
 
 func (x Query_QuerySource) Number() protoreflect.EnumNumber {
@@ -194,7 +353,7 @@ func (x Query_QuerySource) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Query_QuerySource.Descriptor instead.
 func (Query_QuerySource) EnumDescriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{15, 1}
+	return file_proto_dekart_proto_rawDescGZIP(), []int{52, 1}
 }
 
 type File_Status int32
@@ -233,11 +392,11 @@ func (x File_Status) String() string {
 }
 
 func (File_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_dekart_proto_enumTypes[3].Descriptor()
+	return file_proto_dekart_proto_enumTypes[6].Descriptor()
 }
 
 func (File_Status) Type() protoreflect.EnumType {
-	return &file_proto_dekart_proto_enumTypes[3]
+	return &file_proto_dekart_proto_enumTypes[6]
 }
 
 func (x File_Status) Number() protoreflect.EnumNumber {
@@ -246,17 +405,24 @@ func (x File_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use File_Status.Descriptor instead.
 func (File_Status) EnumDescriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{16, 0}
+	return file_proto_dekart_proto_rawDescGZIP(), []int{53, 0}
 }
 
-type GetUsageRequest struct {
+// ApiToken authenticates scripts with Authorization: Bearer header as the user who created it
+type ApiToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix     string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"` // first characters of token to recognize it
+	CreatedAt  int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt int64  `protobuf:"varint,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
 }
 
-func (x *GetUsageRequest) Reset() {
-	*x = GetUsageRequest{}
+func (x *ApiToken) Reset() {
+	*x = ApiToken{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -264,13 +430,13 @@ func (x *GetUsageRequest) Reset() {
 	}
 }
 
-func (x *GetUsageRequest) String() string {
+func (x *ApiToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsageRequest) ProtoMessage() {}
+func (*ApiToken) ProtoMessage() {}
 
-func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+func (x *ApiToken) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -282,24 +448,56 @@ func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
-func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApiToken.ProtoReflect.Descriptor instead.
+func (*ApiToken) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{0}
 }
 
-type GetUsageResponse struct {
+func (x *ApiToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiToken) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiToken) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ApiToken) GetLastUsedAt() int64 {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return 0
+}
+
+type CreateApiTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalReports int64 `protobuf:"varint,1,opt,name=total_reports,json=totalReports,proto3" json:"total_reports,omitempty"`
-	TotalQueries int64 `protobuf:"varint,2,opt,name=total_queries,json=totalQueries,proto3" json:"total_queries,omitempty"`
-	TotalFiles   int64 `protobuf:"varint,3,opt,name=total_files,json=totalFiles,proto3" json:"total_files,omitempty"`
-	TotalAuthors int64 `protobuf:"varint,4,opt,name=total_authors,json=totalAuthors,proto3" json:"total_authors,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GetUsageResponse) Reset() {
-	*x = GetUsageResponse{}
+func (x *CreateApiTokenRequest) Reset() {
+	*x = CreateApiTokenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -307,13 +505,13 @@ func (x *GetUsageResponse) Reset() {
 	}
 }
 
-func (x *GetUsageResponse) String() string {
+func (x *CreateApiTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsageResponse) ProtoMessage() {}
+func (*CreateApiTokenRequest) ProtoMessage() {}
 
-func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateApiTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -325,50 +523,29 @@ func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
-func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateApiTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiTokenRequest) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetUsageResponse) GetTotalReports() int64 {
-	if x != nil {
-		return x.TotalReports
-	}
-	return 0
-}
-
-func (x *GetUsageResponse) GetTotalQueries() int64 {
-	if x != nil {
-		return x.TotalQueries
-	}
-	return 0
-}
-
-func (x *GetUsageResponse) GetTotalFiles() int64 {
-	if x != nil {
-		return x.TotalFiles
-	}
-	return 0
-}
-
-func (x *GetUsageResponse) GetTotalAuthors() int64 {
+func (x *CreateApiTokenRequest) GetName() string {
 	if x != nil {
-		return x.TotalAuthors
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type SetDiscoverableRequest struct {
+type CreateApiTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReportId     string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
-	Discoverable bool   `protobuf:"varint,2,opt,name=discoverable,proto3" json:"discoverable,omitempty"`
+	ApiToken *ApiToken `protobuf:"bytes,1,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
+	Token    string    `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // returned only once, only hash of the token is stored
 }
 
-func (x *SetDiscoverableRequest) Reset() {
-	*x = SetDiscoverableRequest{}
+func (x *CreateApiTokenResponse) Reset() {
+	*x = CreateApiTokenResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -376,13 +553,13 @@ func (x *SetDiscoverableRequest) Reset() {
 	}
 }
 
-func (x *SetDiscoverableRequest) String() string {
+func (x *CreateApiTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetDiscoverableRequest) ProtoMessage() {}
+func (*CreateApiTokenResponse) ProtoMessage() {}
 
-func (x *SetDiscoverableRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateApiTokenResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -394,33 +571,33 @@ func (x *SetDiscoverableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetDiscoverableRequest.ProtoReflect.Descriptor instead.
-func (*SetDiscoverableRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateApiTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiTokenResponse) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *SetDiscoverableRequest) GetReportId() string {
+func (x *CreateApiTokenResponse) GetApiToken() *ApiToken {
 	if x != nil {
-		return x.ReportId
+		return x.ApiToken
 	}
-	return ""
+	return nil
 }
 
-func (x *SetDiscoverableRequest) GetDiscoverable() bool {
+func (x *CreateApiTokenResponse) GetToken() string {
 	if x != nil {
-		return x.Discoverable
+		return x.Token
 	}
-	return false
+	return ""
 }
 
-type SetDiscoverableResponse struct {
+type GetApiTokensRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetDiscoverableResponse) Reset() {
-	*x = SetDiscoverableResponse{}
+func (x *GetApiTokensRequest) Reset() {
+	*x = GetApiTokensRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -428,13 +605,13 @@ func (x *SetDiscoverableResponse) Reset() {
 	}
 }
 
-func (x *SetDiscoverableResponse) String() string {
+func (x *GetApiTokensRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetDiscoverableResponse) ProtoMessage() {}
+func (*GetApiTokensRequest) ProtoMessage() {}
 
-func (x *SetDiscoverableResponse) ProtoReflect() protoreflect.Message {
+func (x *GetApiTokensRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -446,21 +623,21 @@ func (x *SetDiscoverableResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetDiscoverableResponse.ProtoReflect.Descriptor instead.
-func (*SetDiscoverableResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetApiTokensRequest.ProtoReflect.Descriptor instead.
+func (*GetApiTokensRequest) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{3}
 }
 
-type RemoveDatasetRequest struct {
+type GetApiTokensResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	ApiTokens []*ApiToken `protobuf:"bytes,1,rep,name=api_tokens,json=apiTokens,proto3" json:"api_tokens,omitempty"`
 }
 
-func (x *RemoveDatasetRequest) Reset() {
-	*x = RemoveDatasetRequest{}
+func (x *GetApiTokensResponse) Reset() {
+	*x = GetApiTokensResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -468,13 +645,13 @@ func (x *RemoveDatasetRequest) Reset() {
 	}
 }
 
-func (x *RemoveDatasetRequest) String() string {
+func (x *GetApiTokensResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDatasetRequest) ProtoMessage() {}
+func (*GetApiTokensResponse) ProtoMessage() {}
 
-func (x *RemoveDatasetRequest) ProtoReflect() protoreflect.Message {
+func (x *GetApiTokensResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -486,28 +663,28 @@ func (x *RemoveDatasetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDatasetRequest.ProtoReflect.Descriptor instead.
-func (*RemoveDatasetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetApiTokensResponse.ProtoReflect.Descriptor instead.
+func (*GetApiTokensResponse) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *RemoveDatasetRequest) GetDatasetId() string {
+func (x *GetApiTokensResponse) GetApiTokens() []*ApiToken {
 	if x != nil {
-		return x.DatasetId
+		return x.ApiTokens
 	}
-	return ""
+	return nil
 }
 
-type RemoveDatasetResponse struct {
+type RevokeApiTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	ApiTokenId string `protobuf:"bytes,1,opt,name=api_token_id,json=apiTokenId,proto3" json:"api_token_id,omitempty"`
 }
 
-func (x *RemoveDatasetResponse) Reset() {
-	*x = RemoveDatasetResponse{}
+func (x *RevokeApiTokenRequest) Reset() {
+	*x = RevokeApiTokenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -515,13 +692,13 @@ func (x *RemoveDatasetResponse) Reset() {
 	}
 }
 
-func (x *RemoveDatasetResponse) String() string {
+func (x *RevokeApiTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDatasetResponse) ProtoMessage() {}
+func (*RevokeApiTokenRequest) ProtoMessage() {}
 
-func (x *RemoveDatasetResponse) ProtoReflect() protoreflect.Message {
+func (x *RevokeApiTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -533,28 +710,26 @@ func (x *RemoveDatasetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDatasetResponse.ProtoReflect.Descriptor instead.
-func (*RemoveDatasetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeApiTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiTokenRequest) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RemoveDatasetResponse) GetDatasetId() string {
+func (x *RevokeApiTokenRequest) GetApiTokenId() string {
 	if x != nil {
-		return x.DatasetId
+		return x.ApiTokenId
 	}
 	return ""
 }
 
-type StreamOptions struct {
+type RevokeApiTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Sequence int64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (x *StreamOptions) Reset() {
-	*x = StreamOptions{}
+func (x *RevokeApiTokenResponse) Reset() {
+	*x = RevokeApiTokenResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -562,13 +737,13 @@ func (x *StreamOptions) Reset() {
 	}
 }
 
-func (x *StreamOptions) String() string {
+func (x *RevokeApiTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamOptions) ProtoMessage() {}
+func (*RevokeApiTokenResponse) ProtoMessage() {}
 
-func (x *StreamOptions) ProtoReflect() protoreflect.Message {
+func (x *RevokeApiTokenResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -580,26 +755,26 @@ func (x *StreamOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamOptions.ProtoReflect.Descriptor instead.
-func (*StreamOptions) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeApiTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiTokenResponse) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *StreamOptions) GetSequence() int64 {
-	if x != nil {
-		return x.Sequence
-	}
-	return 0
-}
-
-type GetEnvRequest struct {
+// ReportPermission grants role in report to email or to all emails of domain
+type ReportPermission struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ReportId  string                `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Grantee   string                `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"` // email or @domain
+	Role      ReportPermission_Role `protobuf:"varint,3,opt,name=role,proto3,enum=ReportPermission_Role" json:"role,omitempty"`
+	GrantedBy string                `protobuf:"bytes,4,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	CreatedAt int64                 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *GetEnvRequest) Reset() {
-	*x = GetEnvRequest{}
+func (x *ReportPermission) Reset() {
+	*x = ReportPermission{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -607,13 +782,13 @@ func (x *GetEnvRequest) Reset() {
 	}
 }
 
-func (x *GetEnvRequest) String() string {
+func (x *ReportPermission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEnvRequest) ProtoMessage() {}
+func (*ReportPermission) ProtoMessage() {}
 
-func (x *GetEnvRequest) ProtoReflect() protoreflect.Message {
+func (x *ReportPermission) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -625,21 +800,59 @@ func (x *GetEnvRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEnvRequest.ProtoReflect.Descriptor instead.
-func (*GetEnvRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReportPermission.ProtoReflect.Descriptor instead.
+func (*ReportPermission) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{7}
 }
 
-type GetEnvResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Variables []*GetEnvResponse_Variable `protobuf:"bytes,1,rep,name=variables,proto3" json:"variables,omitempty"`
+func (x *ReportPermission) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
 }
 
-func (x *GetEnvResponse) Reset() {
-	*x = GetEnvResponse{}
+func (x *ReportPermission) GetGrantee() string {
+	if x != nil {
+		return x.Grantee
+	}
+	return ""
+}
+
+func (x *ReportPermission) GetRole() ReportPermission_Role {
+	if x != nil {
+		return x.Role
+	}
+	return ReportPermission_ROLE_UNSPECIFIED
+}
+
+func (x *ReportPermission) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
+}
+
+func (x *ReportPermission) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// ReportParameter is referenced in query text of report as {{name}}; user_email is built-in parameter
+type ReportParameter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type         ReportParameter_Type `protobuf:"varint,2,opt,name=type,proto3,enum=ReportParameter_Type" json:"type,omitempty"`
+	DefaultValue string               `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+}
+
+func (x *ReportParameter) Reset() {
+	*x = ReportParameter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -647,13 +860,13 @@ func (x *GetEnvResponse) Reset() {
 	}
 }
 
-func (x *GetEnvResponse) String() string {
+func (x *ReportParameter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEnvResponse) ProtoMessage() {}
+func (*ReportParameter) ProtoMessage() {}
 
-func (x *GetEnvResponse) ProtoReflect() protoreflect.Message {
+func (x *ReportParameter) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -665,29 +878,43 @@ func (x *GetEnvResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEnvResponse.ProtoReflect.Descriptor instead.
-func (*GetEnvResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReportParameter.ProtoReflect.Descriptor instead.
+func (*ReportParameter) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetEnvResponse) GetVariables() []*GetEnvResponse_Variable {
+func (x *ReportParameter) GetName() string {
 	if x != nil {
-		return x.Variables
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type ArchiveReportRequest struct {
+func (x *ReportParameter) GetType() ReportParameter_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ReportParameter_TYPE_UNSPECIFIED
+}
+
+func (x *ReportParameter) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+type SetReportParametersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
-	Archive  bool   `protobuf:"varint,2,opt,name=archive,proto3" json:"archive,omitempty"`
+	ReportId   string             `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Parameters []*ReportParameter `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty"` // replace all parameters of report
 }
 
-func (x *ArchiveReportRequest) Reset() {
-	*x = ArchiveReportRequest{}
+func (x *SetReportParametersRequest) Reset() {
+	*x = SetReportParametersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -695,13 +922,13 @@ func (x *ArchiveReportRequest) Reset() {
 	}
 }
 
-func (x *ArchiveReportRequest) String() string {
+func (x *SetReportParametersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveReportRequest) ProtoMessage() {}
+func (*SetReportParametersRequest) ProtoMessage() {}
 
-func (x *ArchiveReportRequest) ProtoReflect() protoreflect.Message {
+func (x *SetReportParametersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -713,33 +940,33 @@ func (x *ArchiveReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveReportRequest.ProtoReflect.Descriptor instead.
-func (*ArchiveReportRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetReportParametersRequest.ProtoReflect.Descriptor instead.
+func (*SetReportParametersRequest) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ArchiveReportRequest) GetReportId() string {
+func (x *SetReportParametersRequest) GetReportId() string {
 	if x != nil {
 		return x.ReportId
 	}
 	return ""
 }
 
-func (x *ArchiveReportRequest) GetArchive() bool {
+func (x *SetReportParametersRequest) GetParameters() []*ReportParameter {
 	if x != nil {
-		return x.Archive
+		return x.Parameters
 	}
-	return false
+	return nil
 }
 
-type ArchiveReportResponse struct {
+type SetReportParametersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ArchiveReportResponse) Reset() {
-	*x = ArchiveReportResponse{}
+func (x *SetReportParametersResponse) Reset() {
+	*x = SetReportParametersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -747,13 +974,13 @@ func (x *ArchiveReportResponse) Reset() {
 	}
 }
 
-func (x *ArchiveReportResponse) String() string {
+func (x *SetReportParametersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveReportResponse) ProtoMessage() {}
+func (*SetReportParametersResponse) ProtoMessage() {}
 
-func (x *ArchiveReportResponse) ProtoReflect() protoreflect.Message {
+func (x *SetReportParametersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -765,21 +992,21 @@ func (x *ArchiveReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveReportResponse.ProtoReflect.Descriptor instead.
-func (*ArchiveReportResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetReportParametersResponse.ProtoReflect.Descriptor instead.
+func (*SetReportParametersResponse) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{10}
 }
 
-type ReportListRequest struct {
+type GetReportPermissionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamOptions *StreamOptions `protobuf:"bytes,1,opt,name=stream_options,json=streamOptions,proto3" json:"stream_options,omitempty"`
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
 }
 
-func (x *ReportListRequest) Reset() {
-	*x = ReportListRequest{}
+func (x *GetReportPermissionsRequest) Reset() {
+	*x = GetReportPermissionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -787,13 +1014,13 @@ func (x *ReportListRequest) Reset() {
 	}
 }
 
-func (x *ReportListRequest) String() string {
+func (x *GetReportPermissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportListRequest) ProtoMessage() {}
+func (*GetReportPermissionsRequest) ProtoMessage() {}
 
-func (x *ReportListRequest) ProtoReflect() protoreflect.Message {
+func (x *GetReportPermissionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -805,29 +1032,28 @@ func (x *ReportListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportListRequest.ProtoReflect.Descriptor instead.
-func (*ReportListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetReportPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetReportPermissionsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ReportListRequest) GetStreamOptions() *StreamOptions {
+func (x *GetReportPermissionsRequest) GetReportId() string {
 	if x != nil {
-		return x.StreamOptions
+		return x.ReportId
 	}
-	return nil
+	return ""
 }
 
-type ReportListResponse struct {
+type GetReportPermissionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reports       []*Report      `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
-	StreamOptions *StreamOptions `protobuf:"bytes,2,opt,name=stream_options,json=streamOptions,proto3" json:"stream_options,omitempty"`
+	Permissions []*ReportPermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
 }
 
-func (x *ReportListResponse) Reset() {
-	*x = ReportListResponse{}
+func (x *GetReportPermissionsResponse) Reset() {
+	*x = GetReportPermissionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -835,13 +1061,13 @@ func (x *ReportListResponse) Reset() {
 	}
 }
 
-func (x *ReportListResponse) String() string {
+func (x *GetReportPermissionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportListResponse) ProtoMessage() {}
+func (*GetReportPermissionsResponse) ProtoMessage() {}
 
-func (x *ReportListResponse) ProtoReflect() protoreflect.Message {
+func (x *GetReportPermissionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -853,41 +1079,30 @@ func (x *ReportListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportListResponse.ProtoReflect.Descriptor instead.
-func (*ReportListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetReportPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetReportPermissionsResponse) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ReportListResponse) GetReports() []*Report {
-	if x != nil {
-		return x.Reports
-	}
-	return nil
-}
-
-func (x *ReportListResponse) GetStreamOptions() *StreamOptions {
+func (x *GetReportPermissionsResponse) GetPermissions() []*ReportPermission {
 	if x != nil {
-		return x.StreamOptions
+		return x.Permissions
 	}
 	return nil
 }
 
-type Report struct {
+type SetReportPermissionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	MapConfig    string `protobuf:"bytes,2,opt,name=map_config,json=mapConfig,proto3" json:"map_config,omitempty"`
-	Title        string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Archived     bool   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
-	CanWrite     bool   `protobuf:"varint,5,opt,name=can_write,json=canWrite,proto3" json:"can_write,omitempty"`
-	AuthorEmail  string `protobuf:"bytes,6,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
-	Discoverable bool   `protobuf:"varint,7,opt,name=discoverable,proto3" json:"discoverable,omitempty"` // report is discoverable by other users of the same instance
+	ReportId string                `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Grantee  string                `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Role     ReportPermission_Role `protobuf:"varint,3,opt,name=role,proto3,enum=ReportPermission_Role" json:"role,omitempty"` // ROLE_UNSPECIFIED revokes permission
 }
 
-func (x *Report) Reset() {
-	*x = Report{}
+func (x *SetReportPermissionRequest) Reset() {
+	*x = SetReportPermissionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -895,13 +1110,13 @@ func (x *Report) Reset() {
 	}
 }
 
-func (x *Report) String() string {
+func (x *SetReportPermissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Report) ProtoMessage() {}
+func (*SetReportPermissionRequest) ProtoMessage() {}
 
-func (x *Report) ProtoReflect() protoreflect.Message {
+func (x *SetReportPermissionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -913,90 +1128,103 @@ func (x *Report) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Report.ProtoReflect.Descriptor instead.
-func (*Report) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetReportPermissionRequest.ProtoReflect.Descriptor instead.
+func (*SetReportPermissionRequest) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Report) GetId() string {
+func (x *SetReportPermissionRequest) GetReportId() string {
 	if x != nil {
-		return x.Id
+		return x.ReportId
 	}
 	return ""
 }
 
-func (x *Report) GetMapConfig() string {
+func (x *SetReportPermissionRequest) GetGrantee() string {
 	if x != nil {
-		return x.MapConfig
+		return x.Grantee
 	}
 	return ""
 }
 
-func (x *Report) GetTitle() string {
+func (x *SetReportPermissionRequest) GetRole() ReportPermission_Role {
 	if x != nil {
-		return x.Title
+		return x.Role
 	}
-	return ""
+	return ReportPermission_ROLE_UNSPECIFIED
 }
 
-func (x *Report) GetArchived() bool {
-	if x != nil {
-		return x.Archived
-	}
-	return false
+type SetReportPermissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Report) GetCanWrite() bool {
-	if x != nil {
-		return x.CanWrite
+func (x *SetReportPermissionResponse) Reset() {
+	*x = SetReportPermissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *Report) GetAuthorEmail() string {
-	if x != nil {
-		return x.AuthorEmail
-	}
-	return ""
+func (x *SetReportPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Report) GetDiscoverable() bool {
-	if x != nil {
-		return x.Discoverable
+func (*SetReportPermissionResponse) ProtoMessage() {}
+
+func (x *SetReportPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-type Dataset struct {
+// Deprecated: Use SetReportPermissionResponse.ProtoReflect.Descriptor instead.
+func (*SetReportPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{14}
+}
+
+// ReportRevision is saved state of the report
+type ReportRevision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ReportId  string `protobuf:"bytes,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
-	QueryId   string `protobuf:"bytes,3,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
-	CreatedAt int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt int64  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	FileId    string `protobuf:"bytes,6,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Id             string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReportId       string            `protobuf:"bytes,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Revision       int64             `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	Title          string            `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	MapConfig      string            `protobuf:"bytes,5,opt,name=map_config,json=mapConfig,proto3" json:"map_config,omitempty"`                                                                                                          // empty in revisions list
+	QuerySourceIds map[string]string `protobuf:"bytes,6,rep,name=query_source_ids,json=querySourceIds,proto3" json:"query_source_ids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // query id to query_source_id
+	AuthorEmail    string            `protobuf:"bytes,7,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`                                                                                                    // empty when state was recorded before it was edited and its author is not known
+	CreatedAt      int64             `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *Dataset) Reset() {
-	*x = Dataset{}
+func (x *ReportRevision) Reset() {
+	*x = ReportRevision{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dekart_proto_msgTypes[14]
+		mi := &file_proto_dekart_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Dataset) String() string {
+func (x *ReportRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Dataset) ProtoMessage() {}
+func (*ReportRevision) ProtoMessage() {}
 
-func (x *Dataset) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dekart_proto_msgTypes[14]
+func (x *ReportRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1007,91 +1235,95 @@ func (x *Dataset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Dataset.ProtoReflect.Descriptor instead.
-func (*Dataset) Descriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ReportRevision.ProtoReflect.Descriptor instead.
+func (*ReportRevision) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Dataset) GetId() string {
+func (x *ReportRevision) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Dataset) GetReportId() string {
+func (x *ReportRevision) GetReportId() string {
 	if x != nil {
 		return x.ReportId
 	}
 	return ""
 }
 
-func (x *Dataset) GetQueryId() string {
+func (x *ReportRevision) GetRevision() int64 {
 	if x != nil {
-		return x.QueryId
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ReportRevision) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Dataset) GetCreatedAt() int64 {
+func (x *ReportRevision) GetMapConfig() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.MapConfig
 	}
-	return 0
+	return ""
 }
 
-func (x *Dataset) GetUpdatedAt() int64 {
+func (x *ReportRevision) GetQuerySourceIds() map[string]string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.QuerySourceIds
 	}
-	return 0
+	return nil
 }
 
-func (x *Dataset) GetFileId() string {
+func (x *ReportRevision) GetAuthorEmail() string {
 	if x != nil {
-		return x.FileId
+		return x.AuthorEmail
 	}
 	return ""
 }
 
-type Query struct {
+func (x *ReportRevision) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type RevisionChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// string report_id = 2;
-	QueryText      string            `protobuf:"bytes,3,opt,name=query_text,json=queryText,proto3" json:"query_text,omitempty"`
-	JobStatus      Query_JobStatus   `protobuf:"varint,4,opt,name=job_status,json=jobStatus,proto3,enum=Query_JobStatus" json:"job_status,omitempty"`
-	JobResultId    string            `protobuf:"bytes,5,opt,name=job_result_id,json=jobResultId,proto3" json:"job_result_id,omitempty"`
-	JobError       string            `protobuf:"bytes,6,opt,name=job_error,json=jobError,proto3" json:"job_error,omitempty"`
-	JobDuration    int64             `protobuf:"varint,7,opt,name=job_duration,json=jobDuration,proto3" json:"job_duration,omitempty"`
-	TotalRows      int32             `protobuf:"varint,8,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
-	BytesProcessed int64             `protobuf:"varint,9,opt,name=bytes_processed,json=bytesProcessed,proto3" json:"bytes_processed,omitempty"`
-	ResultSize     int64             `protobuf:"varint,10,opt,name=result_size,json=resultSize,proto3" json:"result_size,omitempty"`
-	CreatedAt      int64             `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      int64             `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	QuerySource    Query_QuerySource `protobuf:"varint,13,opt,name=query_source,json=querySource,proto3,enum=Query_QuerySource" json:"query_source,omitempty"`
-	QuerySourceId  string            `protobuf:"bytes,14,opt,name=query_source_id,json=querySourceId,proto3" json:"query_source_id,omitempty"`
+	Path string              `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"` // like title, map_config.config.mapState.zoom or query_source_ids.<query id>
+	Kind RevisionChange_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=RevisionChange_Kind" json:"kind,omitempty"`
+	From string              `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"` // JSON encoded value
+	To   string              `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`     // JSON encoded value
 }
 
-func (x *Query) Reset() {
-	*x = Query{}
+func (x *RevisionChange) Reset() {
+	*x = RevisionChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dekart_proto_msgTypes[15]
+		mi := &file_proto_dekart_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Query) String() string {
+func (x *RevisionChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Query) ProtoMessage() {}
+func (*RevisionChange) ProtoMessage() {}
 
-func (x *Query) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dekart_proto_msgTypes[15]
+func (x *RevisionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1102,135 +1334,111 @@ func (x *Query) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Query.ProtoReflect.Descriptor instead.
-func (*Query) Descriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RevisionChange.ProtoReflect.Descriptor instead.
+func (*RevisionChange) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Query) GetId() string {
+func (x *RevisionChange) GetPath() string {
 	if x != nil {
-		return x.Id
+		return x.Path
 	}
 	return ""
 }
 
-func (x *Query) GetQueryText() string {
+func (x *RevisionChange) GetKind() RevisionChange_Kind {
 	if x != nil {
-		return x.QueryText
+		return x.Kind
 	}
-	return ""
+	return RevisionChange_KIND_UNSPECIFIED
 }
 
-func (x *Query) GetJobStatus() Query_JobStatus {
+func (x *RevisionChange) GetFrom() string {
 	if x != nil {
-		return x.JobStatus
+		return x.From
 	}
-	return Query_JOB_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *Query) GetJobResultId() string {
+func (x *RevisionChange) GetTo() string {
 	if x != nil {
-		return x.JobResultId
+		return x.To
 	}
 	return ""
 }
 
-func (x *Query) GetJobError() string {
-	if x != nil {
-		return x.JobError
-	}
-	return ""
+type GetReportRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
 }
 
-func (x *Query) GetJobDuration() int64 {
-	if x != nil {
-		return x.JobDuration
-	}
-	return 0
-}
-
-func (x *Query) GetTotalRows() int32 {
-	if x != nil {
-		return x.TotalRows
-	}
-	return 0
-}
-
-func (x *Query) GetBytesProcessed() int64 {
-	if x != nil {
-		return x.BytesProcessed
+func (x *GetReportRevisionsRequest) Reset() {
+	*x = GetReportRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Query) GetResultSize() int64 {
-	if x != nil {
-		return x.ResultSize
-	}
-	return 0
+func (x *GetReportRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Query) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
+func (*GetReportRevisionsRequest) ProtoMessage() {}
 
-func (x *Query) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
+func (x *GetReportRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Query) GetQuerySource() Query_QuerySource {
-	if x != nil {
-		return x.QuerySource
-	}
-	return Query_QUERY_SOURCE_UNSPECIFIED
+// Deprecated: Use GetReportRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*GetReportRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Query) GetQuerySourceId() string {
+func (x *GetReportRevisionsRequest) GetReportId() string {
 	if x != nil {
-		return x.QuerySourceId
+		return x.ReportId
 	}
 	return ""
 }
 
-type File struct {
+type GetReportRevisionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	MimeType    string      `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	Size        int64       `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	SourceId    string      `protobuf:"bytes,5,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
-	CreatedAt   int64       `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   int64       `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	FileStatus  File_Status `protobuf:"varint,8,opt,name=file_status,json=fileStatus,proto3,enum=File_Status" json:"file_status,omitempty"`
-	UploadError string      `protobuf:"bytes,9,opt,name=upload_error,json=uploadError,proto3" json:"upload_error,omitempty"`
+	Revisions []*ReportRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
 }
 
-func (x *File) Reset() {
-	*x = File{}
+func (x *GetReportRevisionsResponse) Reset() {
+	*x = GetReportRevisionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dekart_proto_msgTypes[16]
+		mi := &file_proto_dekart_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *File) String() string {
+func (x *GetReportRevisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*File) ProtoMessage() {}
+func (*GetReportRevisionsResponse) ProtoMessage() {}
 
-func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dekart_proto_msgTypes[16]
+func (x *GetReportRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1241,100 +1449,45 @@ func (x *File) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use File.ProtoReflect.Descriptor instead.
-func (*File) Descriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *File) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *File) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *File) GetMimeType() string {
-	if x != nil {
-		return x.MimeType
-	}
-	return ""
-}
-
-func (x *File) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *File) GetSourceId() string {
-	if x != nil {
-		return x.SourceId
-	}
-	return ""
-}
-
-func (x *File) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-func (x *File) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
-}
-
-func (x *File) GetFileStatus() File_Status {
-	if x != nil {
-		return x.FileStatus
-	}
-	return File_STATUS_UNSPECIFIED
+// Deprecated: Use GetReportRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*GetReportRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *File) GetUploadError() string {
+func (x *GetReportRevisionsResponse) GetRevisions() []*ReportRevision {
 	if x != nil {
-		return x.UploadError
+		return x.Revisions
 	}
-	return ""
+	return nil
 }
 
-type UpdateReportRequest struct {
+type DiffReportRevisionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Report *Report  `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
-	Query  []*Query `protobuf:"bytes,2,rep,name=query,proto3" json:"query,omitempty"`
+	ReportId       string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	FromRevisionId string `protobuf:"bytes,2,opt,name=from_revision_id,json=fromRevisionId,proto3" json:"from_revision_id,omitempty"`
+	ToRevisionId   string `protobuf:"bytes,3,opt,name=to_revision_id,json=toRevisionId,proto3" json:"to_revision_id,omitempty"`
 }
 
-func (x *UpdateReportRequest) Reset() {
-	*x = UpdateReportRequest{}
+func (x *DiffReportRevisionsRequest) Reset() {
+	*x = DiffReportRevisionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dekart_proto_msgTypes[17]
+		mi := &file_proto_dekart_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateReportRequest) String() string {
+func (x *DiffReportRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReportRequest) ProtoMessage() {}
+func (*DiffReportRevisionsRequest) ProtoMessage() {}
 
-func (x *UpdateReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dekart_proto_msgTypes[17]
+func (x *DiffReportRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,48 +1498,57 @@ func (x *UpdateReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReportRequest.ProtoReflect.Descriptor instead.
-func (*UpdateReportRequest) Descriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DiffReportRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffReportRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UpdateReportRequest) GetReport() *Report {
+func (x *DiffReportRevisionsRequest) GetReportId() string {
 	if x != nil {
-		return x.Report
+		return x.ReportId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateReportRequest) GetQuery() []*Query {
+func (x *DiffReportRevisionsRequest) GetFromRevisionId() string {
 	if x != nil {
-		return x.Query
+		return x.FromRevisionId
 	}
-	return nil
+	return ""
 }
 
-type UpdateReportResponse struct {
+func (x *DiffReportRevisionsRequest) GetToRevisionId() string {
+	if x != nil {
+		return x.ToRevisionId
+	}
+	return ""
+}
+
+type DiffReportRevisionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Changes []*RevisionChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (x *UpdateReportResponse) Reset() {
-	*x = UpdateReportResponse{}
+func (x *DiffReportRevisionsResponse) Reset() {
+	*x = DiffReportRevisionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dekart_proto_msgTypes[18]
+		mi := &file_proto_dekart_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateReportResponse) String() string {
+func (x *DiffReportRevisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReportResponse) ProtoMessage() {}
+func (*DiffReportRevisionsResponse) ProtoMessage() {}
 
-func (x *UpdateReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dekart_proto_msgTypes[18]
+func (x *DiffReportRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1397,37 +1559,44 @@ func (x *UpdateReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReportResponse.ProtoReflect.Descriptor instead.
-func (*UpdateReportResponse) Descriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DiffReportRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffReportRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{20}
 }
 
-type RunQueryRequest struct {
+func (x *DiffReportRevisionsResponse) GetChanges() []*RevisionChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type RestoreReportRevisionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QueryId   string `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
-	QueryText string `protobuf:"bytes,2,opt,name=query_text,json=queryText,proto3" json:"query_text,omitempty"`
+	ReportId   string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	RevisionId string `protobuf:"bytes,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
 }
 
-func (x *RunQueryRequest) Reset() {
-	*x = RunQueryRequest{}
+func (x *RestoreReportRevisionRequest) Reset() {
+	*x = RestoreReportRevisionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dekart_proto_msgTypes[19]
+		mi := &file_proto_dekart_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RunQueryRequest) String() string {
+func (x *RestoreReportRevisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunQueryRequest) ProtoMessage() {}
+func (*RestoreReportRevisionRequest) ProtoMessage() {}
 
-func (x *RunQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dekart_proto_msgTypes[19]
+func (x *RestoreReportRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1438,48 +1607,50 @@ func (x *RunQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunQueryRequest.ProtoReflect.Descriptor instead.
-func (*RunQueryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RestoreReportRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreReportRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *RunQueryRequest) GetQueryId() string {
+func (x *RestoreReportRevisionRequest) GetReportId() string {
 	if x != nil {
-		return x.QueryId
+		return x.ReportId
 	}
 	return ""
 }
 
-func (x *RunQueryRequest) GetQueryText() string {
+func (x *RestoreReportRevisionRequest) GetRevisionId() string {
 	if x != nil {
-		return x.QueryText
+		return x.RevisionId
 	}
 	return ""
 }
 
-type RunQueryResponse struct {
+type RestoreReportRevisionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Revision *ReportRevision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"` // new revision created by restore
 }
 
-func (x *RunQueryResponse) Reset() {
-	*x = RunQueryResponse{}
+func (x *RestoreReportRevisionResponse) Reset() {
+	*x = RestoreReportRevisionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dekart_proto_msgTypes[20]
+		mi := &file_proto_dekart_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RunQueryResponse) String() string {
+func (x *RestoreReportRevisionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunQueryResponse) ProtoMessage() {}
+func (*RestoreReportRevisionResponse) ProtoMessage() {}
 
-func (x *RunQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dekart_proto_msgTypes[20]
+func (x *RestoreReportRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1490,36 +1661,53 @@ func (x *RunQueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunQueryResponse.ProtoReflect.Descriptor instead.
-func (*RunQueryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use RestoreReportRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreReportRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{22}
 }
 
-type CancelQueryRequest struct {
+func (x *RestoreReportRevisionResponse) GetRevision() *ReportRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// Schedule refreshes report queries automatically
+type Schedule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QueryId string `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
-}
-
-func (x *CancelQueryRequest) Reset() {
-	*x = CancelQueryRequest{}
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReportId  string `protobuf:"bytes,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	QueryId   string `protobuf:"bytes,3,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"` // empty means all queries of the report
+	Cron      string `protobuf:"bytes,4,opt,name=cron,proto3" json:"cron,omitempty"`                      // standard cron expression or descriptor like @daily; CRON_TZ= prefix sets time zone
+	Enabled   bool   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	NextRunAt int64  `protobuf:"varint,6,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	LastRunAt int64  `protobuf:"varint,7,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	LastError string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt int64  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dekart_proto_msgTypes[21]
+		mi := &file_proto_dekart_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelQueryRequest) String() string {
+func (x *Schedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelQueryRequest) ProtoMessage() {}
+func (*Schedule) ProtoMessage() {}
 
-func (x *CancelQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dekart_proto_msgTypes[21]
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1530,111 +1718,91 @@ func (x *CancelQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelQueryRequest.ProtoReflect.Descriptor instead.
-func (*CancelQueryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CancelQueryRequest) GetQueryId() string {
+func (x *Schedule) GetId() string {
 	if x != nil {
-		return x.QueryId
+		return x.Id
 	}
 	return ""
 }
 
-type CancelQueryResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Schedule) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
 }
 
-func (x *CancelQueryResponse) Reset() {
-	*x = CancelQueryResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dekart_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Schedule) GetQueryId() string {
+	if x != nil {
+		return x.QueryId
 	}
+	return ""
 }
 
-func (x *CancelQueryResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CancelQueryResponse) ProtoMessage() {}
-
-func (x *CancelQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dekart_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Schedule) GetCron() string {
+	if x != nil {
+		return x.Cron
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CancelQueryResponse.ProtoReflect.Descriptor instead.
-func (*CancelQueryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{22}
+	return ""
 }
 
-type CreateDatasetRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+func (x *Schedule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
 }
 
-func (x *CreateDatasetRequest) Reset() {
-	*x = CreateDatasetRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dekart_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Schedule) GetNextRunAt() int64 {
+	if x != nil {
+		return x.NextRunAt
 	}
+	return 0
 }
 
-func (x *CreateDatasetRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Schedule) GetLastRunAt() int64 {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return 0
 }
 
-func (*CreateDatasetRequest) ProtoMessage() {}
-
-func (x *CreateDatasetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dekart_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Schedule) GetLastError() string {
+	if x != nil {
+		return x.LastError
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateDatasetRequest.ProtoReflect.Descriptor instead.
-func (*CreateDatasetRequest) Descriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{23}
+func (x *Schedule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-func (x *CreateDatasetRequest) GetReportId() string {
+func (x *Schedule) GetUpdatedAt() int64 {
 	if x != nil {
-		return x.ReportId
+		return x.UpdatedAt
 	}
-	return ""
+	return 0
 }
 
-type CreateDatasetResponse struct {
+type GetSchedulesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
 }
 
-func (x *CreateDatasetResponse) Reset() {
-	*x = CreateDatasetResponse{}
+func (x *GetSchedulesRequest) Reset() {
+	*x = GetSchedulesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1642,13 +1810,13 @@ func (x *CreateDatasetResponse) Reset() {
 	}
 }
 
-func (x *CreateDatasetResponse) String() string {
+func (x *GetSchedulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDatasetResponse) ProtoMessage() {}
+func (*GetSchedulesRequest) ProtoMessage() {}
 
-func (x *CreateDatasetResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSchedulesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1660,21 +1828,28 @@ func (x *CreateDatasetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDatasetResponse.ProtoReflect.Descriptor instead.
-func (*CreateDatasetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*GetSchedulesRequest) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{24}
 }
 
-type CreateFileRequest struct {
+func (x *GetSchedulesRequest) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+type GetSchedulesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	Schedules []*Schedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
 }
 
-func (x *CreateFileRequest) Reset() {
-	*x = CreateFileRequest{}
+func (x *GetSchedulesResponse) Reset() {
+	*x = GetSchedulesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1682,13 +1857,13 @@ func (x *CreateFileRequest) Reset() {
 	}
 }
 
-func (x *CreateFileRequest) String() string {
+func (x *GetSchedulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFileRequest) ProtoMessage() {}
+func (*GetSchedulesResponse) ProtoMessage() {}
 
-func (x *CreateFileRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSchedulesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1700,28 +1875,30 @@ func (x *CreateFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFileRequest.ProtoReflect.Descriptor instead.
-func (*CreateFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*GetSchedulesResponse) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateFileRequest) GetDatasetId() string {
+func (x *GetSchedulesResponse) GetSchedules() []*Schedule {
 	if x != nil {
-		return x.DatasetId
+		return x.Schedules
 	}
-	return ""
+	return nil
 }
 
-type CreateFileResponse struct {
+type CreateScheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	QueryId  string `protobuf:"bytes,2,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	Cron     string `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
 }
 
-func (x *CreateFileResponse) Reset() {
-	*x = CreateFileResponse{}
+func (x *CreateScheduleRequest) Reset() {
+	*x = CreateScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1729,13 +1906,13 @@ func (x *CreateFileResponse) Reset() {
 	}
 }
 
-func (x *CreateFileResponse) String() string {
+func (x *CreateScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFileResponse) ProtoMessage() {}
+func (*CreateScheduleRequest) ProtoMessage() {}
 
-func (x *CreateFileResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1747,28 +1924,42 @@ func (x *CreateFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFileResponse.ProtoReflect.Descriptor instead.
-func (*CreateFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CreateFileResponse) GetFileId() string {
+func (x *CreateScheduleRequest) GetReportId() string {
 	if x != nil {
-		return x.FileId
+		return x.ReportId
 	}
 	return ""
 }
 
-type CreateQueryRequest struct {
+func (x *CreateScheduleRequest) GetQueryId() string {
+	if x != nil {
+		return x.QueryId
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+type CreateScheduleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	Schedule *Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 }
 
-func (x *CreateQueryRequest) Reset() {
-	*x = CreateQueryRequest{}
+func (x *CreateScheduleResponse) Reset() {
+	*x = CreateScheduleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1776,13 +1967,13 @@ func (x *CreateQueryRequest) Reset() {
 	}
 }
 
-func (x *CreateQueryRequest) String() string {
+func (x *CreateScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateQueryRequest) ProtoMessage() {}
+func (*CreateScheduleResponse) ProtoMessage() {}
 
-func (x *CreateQueryRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateScheduleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1794,28 +1985,30 @@ func (x *CreateQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateQueryRequest.ProtoReflect.Descriptor instead.
-func (*CreateQueryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CreateScheduleResponse) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CreateQueryRequest) GetDatasetId() string {
+func (x *CreateScheduleResponse) GetSchedule() *Schedule {
 	if x != nil {
-		return x.DatasetId
+		return x.Schedule
 	}
-	return ""
+	return nil
 }
 
-type CreateQueryResponse struct {
+type UpdateScheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query *Query `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	ScheduleId string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Cron       string `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"` // cron expression is not changed when empty
+	Enabled    bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (x *CreateQueryResponse) Reset() {
-	*x = CreateQueryResponse{}
+func (x *UpdateScheduleRequest) Reset() {
+	*x = UpdateScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1823,13 +2016,13 @@ func (x *CreateQueryResponse) Reset() {
 	}
 }
 
-func (x *CreateQueryResponse) String() string {
+func (x *UpdateScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateQueryResponse) ProtoMessage() {}
+func (*UpdateScheduleRequest) ProtoMessage() {}
 
-func (x *CreateQueryResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1841,29 +2034,42 @@ func (x *CreateQueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateQueryResponse.ProtoReflect.Descriptor instead.
-func (*CreateQueryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateQueryResponse) GetQuery() *Query {
+func (x *UpdateScheduleRequest) GetScheduleId() string {
 	if x != nil {
-		return x.Query
+		return x.ScheduleId
 	}
-	return nil
+	return ""
 }
 
-type ReportStreamRequest struct {
+func (x *UpdateScheduleRequest) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type UpdateScheduleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Report        *Report        `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
-	StreamOptions *StreamOptions `protobuf:"bytes,2,opt,name=stream_options,json=streamOptions,proto3" json:"stream_options,omitempty"`
+	Schedule *Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 }
 
-func (x *ReportStreamRequest) Reset() {
-	*x = ReportStreamRequest{}
+func (x *UpdateScheduleResponse) Reset() {
+	*x = UpdateScheduleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1871,13 +2077,13 @@ func (x *ReportStreamRequest) Reset() {
 	}
 }
 
-func (x *ReportStreamRequest) String() string {
+func (x *UpdateScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportStreamRequest) ProtoMessage() {}
+func (*UpdateScheduleResponse) ProtoMessage() {}
 
-func (x *ReportStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateScheduleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1889,39 +2095,28 @@ func (x *ReportStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportStreamRequest.ProtoReflect.Descriptor instead.
-func (*ReportStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleResponse) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ReportStreamRequest) GetReport() *Report {
-	if x != nil {
-		return x.Report
-	}
-	return nil
-}
-
-func (x *ReportStreamRequest) GetStreamOptions() *StreamOptions {
+func (x *UpdateScheduleResponse) GetSchedule() *Schedule {
 	if x != nil {
-		return x.StreamOptions
+		return x.Schedule
 	}
 	return nil
 }
 
-type ReportStreamResponse struct {
+type DeleteScheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Report        *Report        `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
-	Queries       []*Query       `protobuf:"bytes,2,rep,name=queries,proto3" json:"queries,omitempty"`
-	StreamOptions *StreamOptions `protobuf:"bytes,3,opt,name=stream_options,json=streamOptions,proto3" json:"stream_options,omitempty"`
-	Datasets      []*Dataset     `protobuf:"bytes,4,rep,name=datasets,proto3" json:"datasets,omitempty"`
-	Files         []*File        `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	ScheduleId string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 }
 
-func (x *ReportStreamResponse) Reset() {
-	*x = ReportStreamResponse{}
+func (x *DeleteScheduleRequest) Reset() {
+	*x = DeleteScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1929,13 +2124,13 @@ func (x *ReportStreamResponse) Reset() {
 	}
 }
 
-func (x *ReportStreamResponse) String() string {
+func (x *DeleteScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportStreamResponse) ProtoMessage() {}
+func (*DeleteScheduleRequest) ProtoMessage() {}
 
-func (x *ReportStreamResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1947,56 +2142,26 @@ func (x *ReportStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportStreamResponse.ProtoReflect.Descriptor instead.
-func (*ReportStreamResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ReportStreamResponse) GetReport() *Report {
-	if x != nil {
-		return x.Report
-	}
-	return nil
-}
-
-func (x *ReportStreamResponse) GetQueries() []*Query {
-	if x != nil {
-		return x.Queries
-	}
-	return nil
-}
-
-func (x *ReportStreamResponse) GetStreamOptions() *StreamOptions {
-	if x != nil {
-		return x.StreamOptions
-	}
-	return nil
-}
-
-func (x *ReportStreamResponse) GetDatasets() []*Dataset {
-	if x != nil {
-		return x.Datasets
-	}
-	return nil
-}
-
-func (x *ReportStreamResponse) GetFiles() []*File {
+func (x *DeleteScheduleRequest) GetScheduleId() string {
 	if x != nil {
-		return x.Files
+		return x.ScheduleId
 	}
-	return nil
+	return ""
 }
 
-type ForkReportRequest struct {
+type DeleteScheduleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
 }
 
-func (x *ForkReportRequest) Reset() {
-	*x = ForkReportRequest{}
+func (x *DeleteScheduleResponse) Reset() {
+	*x = DeleteScheduleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2004,13 +2169,13 @@ func (x *ForkReportRequest) Reset() {
 	}
 }
 
-func (x *ForkReportRequest) String() string {
+func (x *DeleteScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForkReportRequest) ProtoMessage() {}
+func (*DeleteScheduleResponse) ProtoMessage() {}
 
-func (x *ForkReportRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteScheduleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2022,28 +2187,19 @@ func (x *ForkReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForkReportRequest.ProtoReflect.Descriptor instead.
-func (*ForkReportRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleResponse) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ForkReportRequest) GetReportId() string {
-	if x != nil {
-		return x.ReportId
-	}
-	return ""
-}
-
-type ForkReportResponse struct {
+type GetUsageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
 }
 
-func (x *ForkReportResponse) Reset() {
-	*x = ForkReportResponse{}
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dekart_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2051,13 +2207,13 @@ func (x *ForkReportResponse) Reset() {
 	}
 }
 
-func (x *ForkReportResponse) String() string {
+func (x *GetUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForkReportResponse) ProtoMessage() {}
+func (*GetUsageRequest) ProtoMessage() {}
 
-func (x *ForkReportResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dekart_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2069,41 +2225,286 @@ func (x *ForkReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForkReportResponse.ProtoReflect.Descriptor instead.
-func (*ForkReportResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
 	return file_proto_dekart_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ForkReportResponse) GetReportId() string {
+type GetUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalReports int64 `protobuf:"varint,1,opt,name=total_reports,json=totalReports,proto3" json:"total_reports,omitempty"`
+	TotalQueries int64 `protobuf:"varint,2,opt,name=total_queries,json=totalQueries,proto3" json:"total_queries,omitempty"`
+	TotalFiles   int64 `protobuf:"varint,3,opt,name=total_files,json=totalFiles,proto3" json:"total_files,omitempty"`
+	TotalAuthors int64 `protobuf:"varint,4,opt,name=total_authors,json=totalAuthors,proto3" json:"total_authors,omitempty"`
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetUsageResponse) GetTotalReports() int64 {
+	if x != nil {
+		return x.TotalReports
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetTotalQueries() int64 {
+	if x != nil {
+		return x.TotalQueries
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetTotalFiles() int64 {
+	if x != nil {
+		return x.TotalFiles
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetTotalAuthors() int64 {
+	if x != nil {
+		return x.TotalAuthors
+	}
+	return 0
+}
+
+type GetQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetQuotaRequest) Reset() {
+	*x = GetQuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaRequest) ProtoMessage() {}
+
+func (x *GetQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{34}
+}
+
+// GetQuotaResponse is quota usage of the current user, limit 0 means no limit
+type GetQuotaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunningJobs              int64 `protobuf:"varint,1,opt,name=running_jobs,json=runningJobs,proto3" json:"running_jobs,omitempty"`
+	MaxRunningJobs           int64 `protobuf:"varint,2,opt,name=max_running_jobs,json=maxRunningJobs,proto3" json:"max_running_jobs,omitempty"`
+	DeploymentRunningJobs    int64 `protobuf:"varint,3,opt,name=deployment_running_jobs,json=deploymentRunningJobs,proto3" json:"deployment_running_jobs,omitempty"`
+	MaxDeploymentRunningJobs int64 `protobuf:"varint,4,opt,name=max_deployment_running_jobs,json=maxDeploymentRunningJobs,proto3" json:"max_deployment_running_jobs,omitempty"`
+	BytesProcessedToday      int64 `protobuf:"varint,5,opt,name=bytes_processed_today,json=bytesProcessedToday,proto3" json:"bytes_processed_today,omitempty"`
+	MaxBytesProcessedPerDay  int64 `protobuf:"varint,6,opt,name=max_bytes_processed_per_day,json=maxBytesProcessedPerDay,proto3" json:"max_bytes_processed_per_day,omitempty"`
+}
+
+func (x *GetQuotaResponse) Reset() {
+	*x = GetQuotaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaResponse) ProtoMessage() {}
+
+func (x *GetQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetQuotaResponse) GetRunningJobs() int64 {
+	if x != nil {
+		return x.RunningJobs
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetMaxRunningJobs() int64 {
+	if x != nil {
+		return x.MaxRunningJobs
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetDeploymentRunningJobs() int64 {
+	if x != nil {
+		return x.DeploymentRunningJobs
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetMaxDeploymentRunningJobs() int64 {
+	if x != nil {
+		return x.MaxDeploymentRunningJobs
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetBytesProcessedToday() int64 {
+	if x != nil {
+		return x.BytesProcessedToday
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetMaxBytesProcessedPerDay() int64 {
+	if x != nil {
+		return x.MaxBytesProcessedPerDay
+	}
+	return 0
+}
+
+type SetDiscoverableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportId     string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Discoverable bool   `protobuf:"varint,2,opt,name=discoverable,proto3" json:"discoverable,omitempty"`
+}
+
+func (x *SetDiscoverableRequest) Reset() {
+	*x = SetDiscoverableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDiscoverableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDiscoverableRequest) ProtoMessage() {}
+
+func (x *SetDiscoverableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDiscoverableRequest.ProtoReflect.Descriptor instead.
+func (*SetDiscoverableRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetDiscoverableRequest) GetReportId() string {
 	if x != nil {
 		return x.ReportId
 	}
 	return ""
 }
 
-type CreateReportRequest struct {
+func (x *SetDiscoverableRequest) GetDiscoverable() bool {
+	if x != nil {
+		return x.Discoverable
+	}
+	return false
+}
+
+type SetDiscoverableResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CreateReportRequest) Reset() {
-	*x = CreateReportRequest{}
+func (x *SetDiscoverableResponse) Reset() {
+	*x = SetDiscoverableResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dekart_proto_msgTypes[33]
+		mi := &file_proto_dekart_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateReportRequest) String() string {
+func (x *SetDiscoverableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReportRequest) ProtoMessage() {}
+func (*SetDiscoverableResponse) ProtoMessage() {}
 
-func (x *CreateReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dekart_proto_msgTypes[33]
+func (x *SetDiscoverableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2114,531 +2515,3589 @@ func (x *CreateReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReportRequest.ProtoReflect.Descriptor instead.
-func (*CreateReportRequest) Descriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use SetDiscoverableResponse.ProtoReflect.Descriptor instead.
+func (*SetDiscoverableResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{37}
 }
 
-type CreateReportResponse struct {
+type SetLinkSharingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Report *Report `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	ReportId    string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	LinkSharing bool   `protobuf:"varint,2,opt,name=link_sharing,json=linkSharing,proto3" json:"link_sharing,omitempty"`
 }
 
-func (x *CreateReportResponse) Reset() {
-	*x = CreateReportResponse{}
+func (x *SetLinkSharingRequest) Reset() {
+	*x = SetLinkSharingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dekart_proto_msgTypes[34]
+		mi := &file_proto_dekart_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateReportResponse) String() string {
+func (x *SetLinkSharingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReportResponse) ProtoMessage() {}
+func (*SetLinkSharingRequest) ProtoMessage() {}
 
-func (x *CreateReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dekart_proto_msgTypes[34]
+func (x *SetLinkSharingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateReportResponse.ProtoReflect.Descriptor instead.
-func (*CreateReportResponse) Descriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *CreateReportResponse) GetReport() *Report {
-	if x != nil {
-		return x.Report
-	}
-	return nil
-}
-
-type GetEnvResponse_Variable struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Type  GetEnvResponse_Variable_Type `protobuf:"varint,1,opt,name=type,proto3,enum=GetEnvResponse_Variable_Type" json:"type,omitempty"`
-	Value string                       `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-}
-
-func (x *GetEnvResponse_Variable) Reset() {
-	*x = GetEnvResponse_Variable{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dekart_proto_msgTypes[35]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetEnvResponse_Variable) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetEnvResponse_Variable) ProtoMessage() {}
-
-func (x *GetEnvResponse_Variable) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dekart_proto_msgTypes[35]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLinkSharingRequest.ProtoReflect.Descriptor instead.
+func (*SetLinkSharingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetLinkSharingRequest) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *SetLinkSharingRequest) GetLinkSharing() bool {
+	if x != nil {
+		return x.LinkSharing
+	}
+	return false
+}
+
+type SetLinkSharingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetLinkSharingResponse) Reset() {
+	*x = SetLinkSharingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLinkSharingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLinkSharingResponse) ProtoMessage() {}
+
+func (x *SetLinkSharingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLinkSharingResponse.ProtoReflect.Descriptor instead.
+func (*SetLinkSharingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{39}
+}
+
+type RemoveDatasetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+}
+
+func (x *RemoveDatasetRequest) Reset() {
+	*x = RemoveDatasetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveDatasetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDatasetRequest) ProtoMessage() {}
+
+func (x *RemoveDatasetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDatasetRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDatasetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RemoveDatasetRequest) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+type RemoveDatasetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+}
+
+func (x *RemoveDatasetResponse) Reset() {
+	*x = RemoveDatasetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveDatasetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDatasetResponse) ProtoMessage() {}
+
+func (x *RemoveDatasetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDatasetResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDatasetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RemoveDatasetResponse) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+type StreamOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence int64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *StreamOptions) Reset() {
+	*x = StreamOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamOptions) ProtoMessage() {}
+
+func (x *StreamOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamOptions.ProtoReflect.Descriptor instead.
+func (*StreamOptions) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StreamOptions) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type GetEnvRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetEnvRequest) Reset() {
+	*x = GetEnvRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEnvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnvRequest) ProtoMessage() {}
+
+func (x *GetEnvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnvRequest.ProtoReflect.Descriptor instead.
+func (*GetEnvRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{43}
+}
+
+type GetEnvResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Variables   []*GetEnvResponse_Variable `protobuf:"bytes,1,rep,name=variables,proto3" json:"variables,omitempty"`
+	Datasources []*Datasource              `protobuf:"bytes,2,rep,name=datasources,proto3" json:"datasources,omitempty"`
+}
+
+func (x *GetEnvResponse) Reset() {
+	*x = GetEnvResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEnvResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnvResponse) ProtoMessage() {}
+
+func (x *GetEnvResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnvResponse.ProtoReflect.Descriptor instead.
+func (*GetEnvResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetEnvResponse) GetVariables() []*GetEnvResponse_Variable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *GetEnvResponse) GetDatasources() []*Datasource {
+	if x != nil {
+		return x.Datasources
+	}
+	return nil
+}
+
+type Datasource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // BQ, SNOWFLAKE, ATHENA, PG
+}
+
+func (x *Datasource) Reset() {
+	*x = Datasource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Datasource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Datasource) ProtoMessage() {}
+
+func (x *Datasource) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Datasource.ProtoReflect.Descriptor instead.
+func (*Datasource) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Datasource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Datasource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ArchiveReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Archive  bool   `protobuf:"varint,2,opt,name=archive,proto3" json:"archive,omitempty"`
+}
+
+func (x *ArchiveReportRequest) Reset() {
+	*x = ArchiveReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveReportRequest) ProtoMessage() {}
+
+func (x *ArchiveReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveReportRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ArchiveReportRequest) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *ArchiveReportRequest) GetArchive() bool {
+	if x != nil {
+		return x.Archive
+	}
+	return false
+}
+
+type ArchiveReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ArchiveReportResponse) Reset() {
+	*x = ArchiveReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveReportResponse) ProtoMessage() {}
+
+func (x *ArchiveReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveReportResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{47}
+}
+
+type ReportListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamOptions *StreamOptions `protobuf:"bytes,1,opt,name=stream_options,json=streamOptions,proto3" json:"stream_options,omitempty"`
+}
+
+func (x *ReportListRequest) Reset() {
+	*x = ReportListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportListRequest) ProtoMessage() {}
+
+func (x *ReportListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportListRequest.ProtoReflect.Descriptor instead.
+func (*ReportListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReportListRequest) GetStreamOptions() *StreamOptions {
+	if x != nil {
+		return x.StreamOptions
+	}
+	return nil
+}
+
+type ReportListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reports       []*Report      `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	StreamOptions *StreamOptions `protobuf:"bytes,2,opt,name=stream_options,json=streamOptions,proto3" json:"stream_options,omitempty"`
+}
+
+func (x *ReportListResponse) Reset() {
+	*x = ReportListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportListResponse) ProtoMessage() {}
+
+func (x *ReportListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportListResponse.ProtoReflect.Descriptor instead.
+func (*ReportListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ReportListResponse) GetReports() []*Report {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *ReportListResponse) GetStreamOptions() *StreamOptions {
+	if x != nil {
+		return x.StreamOptions
+	}
+	return nil
+}
+
+type Report struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MapConfig    string             `protobuf:"bytes,2,opt,name=map_config,json=mapConfig,proto3" json:"map_config,omitempty"`
+	Title        string             `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Archived     bool               `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	CanWrite     bool               `protobuf:"varint,5,opt,name=can_write,json=canWrite,proto3" json:"can_write,omitempty"`
+	AuthorEmail  string             `protobuf:"bytes,6,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	Discoverable bool               `protobuf:"varint,7,opt,name=discoverable,proto3" json:"discoverable,omitempty"`         // report is discoverable by other users of the same instance
+	IsAuthor     bool               `protobuf:"varint,8,opt,name=is_author,json=isAuthor,proto3" json:"is_author,omitempty"` // only author can archive report and manage permissions
+	Parameters   []*ReportParameter `protobuf:"bytes,9,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	LinkSharing  bool               `protobuf:"varint,10,opt,name=link_sharing,json=linkSharing,proto3" json:"link_sharing,omitempty"` // report is readable by anyone with link, otherwise only by users with permission
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Report) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Report) GetMapConfig() string {
+	if x != nil {
+		return x.MapConfig
+	}
+	return ""
+}
+
+func (x *Report) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Report) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Report) GetCanWrite() bool {
+	if x != nil {
+		return x.CanWrite
+	}
+	return false
+}
+
+func (x *Report) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *Report) GetDiscoverable() bool {
+	if x != nil {
+		return x.Discoverable
+	}
+	return false
+}
+
+func (x *Report) GetIsAuthor() bool {
+	if x != nil {
+		return x.IsAuthor
+	}
+	return false
+}
+
+func (x *Report) GetParameters() []*ReportParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *Report) GetLinkSharing() bool {
+	if x != nil {
+		return x.LinkSharing
+	}
+	return false
+}
+
+type Dataset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReportId  string `protobuf:"bytes,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	QueryId   string `protobuf:"bytes,3,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	CreatedAt int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FileId    string `protobuf:"bytes,6,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+}
+
+func (x *Dataset) Reset() {
+	*x = Dataset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Dataset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dataset) ProtoMessage() {}
+
+func (x *Dataset) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dataset.ProtoReflect.Descriptor instead.
+func (*Dataset) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Dataset) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Dataset) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *Dataset) GetQueryId() string {
+	if x != nil {
+		return x.QueryId
+	}
+	return ""
+}
+
+func (x *Dataset) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Dataset) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Dataset) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+type Query struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// string report_id = 2;
+	QueryText      string            `protobuf:"bytes,3,opt,name=query_text,json=queryText,proto3" json:"query_text,omitempty"`
+	JobStatus      Query_JobStatus   `protobuf:"varint,4,opt,name=job_status,json=jobStatus,proto3,enum=Query_JobStatus" json:"job_status,omitempty"`
+	JobResultId    string            `protobuf:"bytes,5,opt,name=job_result_id,json=jobResultId,proto3" json:"job_result_id,omitempty"`
+	JobError       string            `protobuf:"bytes,6,opt,name=job_error,json=jobError,proto3" json:"job_error,omitempty"`
+	JobDuration    int64             `protobuf:"varint,7,opt,name=job_duration,json=jobDuration,proto3" json:"job_duration,omitempty"`
+	TotalRows      int32             `protobuf:"varint,8,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	BytesProcessed int64             `protobuf:"varint,9,opt,name=bytes_processed,json=bytesProcessed,proto3" json:"bytes_processed,omitempty"`
+	ResultSize     int64             `protobuf:"varint,10,opt,name=result_size,json=resultSize,proto3" json:"result_size,omitempty"`
+	CreatedAt      int64             `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      int64             `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	QuerySource    Query_QuerySource `protobuf:"varint,13,opt,name=query_source,json=querySource,proto3,enum=Query_QuerySource" json:"query_source,omitempty"`
+	QuerySourceId  string            `protobuf:"bytes,14,opt,name=query_source_id,json=querySourceId,proto3" json:"query_source_id,omitempty"`
+	DatasourceId   string            `protobuf:"bytes,15,opt,name=datasource_id,json=datasourceId,proto3" json:"datasource_id,omitempty"`    // empty means default datasource
+	Schema         []*Column         `protobuf:"bytes,17,rep,name=schema,proto3" json:"schema,omitempty"`                                    // result columns, empty until job is done
+	JobTruncation  string            `protobuf:"bytes,18,opt,name=job_truncation,json=jobTruncation,proto3" json:"job_truncation,omitempty"` // reason result was truncated by row or size limit, empty when result is complete
+}
+
+func (x *Query) Reset() {
+	*x = Query{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Query) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Query) ProtoMessage() {}
+
+func (x *Query) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Query.ProtoReflect.Descriptor instead.
+func (*Query) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Query) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Query) GetQueryText() string {
+	if x != nil {
+		return x.QueryText
+	}
+	return ""
+}
+
+func (x *Query) GetJobStatus() Query_JobStatus {
+	if x != nil {
+		return x.JobStatus
+	}
+	return Query_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *Query) GetJobResultId() string {
+	if x != nil {
+		return x.JobResultId
+	}
+	return ""
+}
+
+func (x *Query) GetJobError() string {
+	if x != nil {
+		return x.JobError
+	}
+	return ""
+}
+
+func (x *Query) GetJobDuration() int64 {
+	if x != nil {
+		return x.JobDuration
+	}
+	return 0
+}
+
+func (x *Query) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *Query) GetBytesProcessed() int64 {
+	if x != nil {
+		return x.BytesProcessed
+	}
+	return 0
+}
+
+func (x *Query) GetResultSize() int64 {
+	if x != nil {
+		return x.ResultSize
+	}
+	return 0
+}
+
+func (x *Query) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Query) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Query) GetQuerySource() Query_QuerySource {
+	if x != nil {
+		return x.QuerySource
+	}
+	return Query_QUERY_SOURCE_UNSPECIFIED
+}
+
+func (x *Query) GetQuerySourceId() string {
+	if x != nil {
+		return x.QuerySourceId
+	}
+	return ""
+}
+
+func (x *Query) GetDatasourceId() string {
+	if x != nil {
+		return x.DatasourceId
+	}
+	return ""
+}
+
+func (x *Query) GetSchema() []*Column {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *Query) GetJobTruncation() string {
+	if x != nil {
+		return x.JobTruncation
+	}
+	return ""
+}
+
+type File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MimeType       string      `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size           int64       `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	SourceId       string      `protobuf:"bytes,5,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	CreatedAt      int64       `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      int64       `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FileStatus     File_Status `protobuf:"varint,8,opt,name=file_status,json=fileStatus,proto3,enum=File_Status" json:"file_status,omitempty"`
+	UploadError    string      `protobuf:"bytes,9,opt,name=upload_error,json=uploadError,proto3" json:"upload_error,omitempty"`
+	RowCount       int64       `protobuf:"varint,10,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`    // rows in converted file, set for parquet uploads
+	Schema         []*Column   `protobuf:"bytes,11,rep,name=schema,proto3" json:"schema,omitempty"`                         // columns of converted file
+	ChunkSize      int64       `protobuf:"varint,12,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"` // resumable upload in progress when chunk_count > 0 and file_status is STATUS_NEW
+	ChunkCount     int32       `protobuf:"varint,13,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	UploadedChunks []int32     `protobuf:"varint,14,rep,packed,name=uploaded_chunks,json=uploadedChunks,proto3" json:"uploaded_chunks,omitempty"`
+}
+
+func (x *File) Reset() {
+	*x = File{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*File) ProtoMessage() {}
+
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *File) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *File) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *File) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *File) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *File) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *File) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *File) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *File) GetFileStatus() File_Status {
+	if x != nil {
+		return x.FileStatus
+	}
+	return File_STATUS_UNSPECIFIED
+}
+
+func (x *File) GetUploadError() string {
+	if x != nil {
+		return x.UploadError
+	}
+	return ""
+}
+
+func (x *File) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *File) GetSchema() []*Column {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *File) GetChunkSize() int64 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *File) GetChunkCount() int32 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+func (x *File) GetUploadedChunks() []int32 {
+	if x != nil {
+		return x.UploadedChunks
+	}
+	return nil
+}
+
+type Column struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // type in datasource or file
+	Nullable bool   `protobuf:"varint,3,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	Geometry bool   `protobuf:"varint,4,opt,name=geometry,proto3" json:"geometry,omitempty"`
+}
+
+func (x *Column) Reset() {
+	*x = Column{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Column) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Column) ProtoMessage() {}
+
+func (x *Column) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Column.ProtoReflect.Descriptor instead.
+func (*Column) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Column) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Column) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Column) GetNullable() bool {
+	if x != nil {
+		return x.Nullable
+	}
+	return false
+}
+
+func (x *Column) GetGeometry() bool {
+	if x != nil {
+		return x.Geometry
+	}
+	return false
+}
+
+type UpdateReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report *Report  `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Query  []*Query `protobuf:"bytes,2,rep,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *UpdateReportRequest) Reset() {
+	*x = UpdateReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReportRequest) ProtoMessage() {}
+
+func (x *UpdateReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReportRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateReportRequest) GetReport() *Report {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *UpdateReportRequest) GetQuery() []*Query {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type UpdateReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateReportResponse) Reset() {
+	*x = UpdateReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReportResponse) ProtoMessage() {}
+
+func (x *UpdateReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReportResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{56}
+}
+
+type RunQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueryId      string `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	QueryText    string `protobuf:"bytes,2,opt,name=query_text,json=queryText,proto3" json:"query_text,omitempty"`
+	DatasourceId string `protobuf:"bytes,3,opt,name=datasource_id,json=datasourceId,proto3" json:"datasource_id,omitempty"`  // when set, query is moved to this datasource
+	ForceRefresh bool   `protobuf:"varint,4,opt,name=force_refresh,json=forceRefresh,proto3" json:"force_refresh,omitempty"` // run query even if cached result is available
+	// limits of this run, they can only be stricter than datasource limits; 0 means datasource limit
+	TimeoutSeconds int64             `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	MaxRows        int64             `protobuf:"varint,6,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	MaxResultBytes int64             `protobuf:"varint,7,opt,name=max_result_bytes,json=maxResultBytes,proto3" json:"max_result_bytes,omitempty"`
+	Parameters     map[string]string `protobuf:"bytes,8,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // values of report parameters, default values are used for missing ones
+}
+
+func (x *RunQueryRequest) Reset() {
+	*x = RunQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunQueryRequest) ProtoMessage() {}
+
+func (x *RunQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunQueryRequest.ProtoReflect.Descriptor instead.
+func (*RunQueryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RunQueryRequest) GetQueryId() string {
+	if x != nil {
+		return x.QueryId
+	}
+	return ""
+}
+
+func (x *RunQueryRequest) GetQueryText() string {
+	if x != nil {
+		return x.QueryText
+	}
+	return ""
+}
+
+func (x *RunQueryRequest) GetDatasourceId() string {
+	if x != nil {
+		return x.DatasourceId
+	}
+	return ""
+}
+
+func (x *RunQueryRequest) GetForceRefresh() bool {
+	if x != nil {
+		return x.ForceRefresh
+	}
+	return false
+}
+
+func (x *RunQueryRequest) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *RunQueryRequest) GetMaxRows() int64 {
+	if x != nil {
+		return x.MaxRows
+	}
+	return 0
+}
+
+func (x *RunQueryRequest) GetMaxResultBytes() int64 {
+	if x != nil {
+		return x.MaxResultBytes
+	}
+	return 0
+}
+
+func (x *RunQueryRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type RunQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RunQueryResponse) Reset() {
+	*x = RunQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunQueryResponse) ProtoMessage() {}
+
+func (x *RunQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunQueryResponse.ProtoReflect.Descriptor instead.
+func (*RunQueryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{58}
+}
+
+type EstimateQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueryId      string            `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	QueryText    string            `protobuf:"bytes,2,opt,name=query_text,json=queryText,proto3" json:"query_text,omitempty"`
+	DatasourceId string            `protobuf:"bytes,3,opt,name=datasource_id,json=datasourceId,proto3" json:"datasource_id,omitempty"`                                                                 // empty means datasource of the query
+	Parameters   map[string]string `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // values of report parameters, default values are used for missing ones
+}
+
+func (x *EstimateQueryRequest) Reset() {
+	*x = EstimateQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateQueryRequest) ProtoMessage() {}
+
+func (x *EstimateQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateQueryRequest.ProtoReflect.Descriptor instead.
+func (*EstimateQueryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *EstimateQueryRequest) GetQueryId() string {
+	if x != nil {
+		return x.QueryId
+	}
+	return ""
+}
+
+func (x *EstimateQueryRequest) GetQueryText() string {
+	if x != nil {
+		return x.QueryText
+	}
+	return ""
+}
+
+func (x *EstimateQueryRequest) GetDatasourceId() string {
+	if x != nil {
+		return x.DatasourceId
+	}
+	return ""
+}
+
+func (x *EstimateQueryRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type EstimateQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EstimatedBytes int64   `protobuf:"varint,1,opt,name=estimated_bytes,json=estimatedBytes,proto3" json:"estimated_bytes,omitempty"` // bytes scanned by query
+	EstimatedCost  float64 `protobuf:"fixed64,2,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`   // in units of DEKART_QUERY_COST_PER_TB, 0 when not configured
+	LimitBytes     int64   `protobuf:"varint,3,opt,name=limit_bytes,json=limitBytes,proto3" json:"limit_bytes,omitempty"`             // estimate limit applied to the user, 0 when not limited
+	ExceedsLimit   bool    `protobuf:"varint,4,opt,name=exceeds_limit,json=exceedsLimit,proto3" json:"exceeds_limit,omitempty"`       // RunQuery refuses the query
+}
+
+func (x *EstimateQueryResponse) Reset() {
+	*x = EstimateQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateQueryResponse) ProtoMessage() {}
+
+func (x *EstimateQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateQueryResponse.ProtoReflect.Descriptor instead.
+func (*EstimateQueryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *EstimateQueryResponse) GetEstimatedBytes() int64 {
+	if x != nil {
+		return x.EstimatedBytes
+	}
+	return 0
+}
+
+func (x *EstimateQueryResponse) GetEstimatedCost() float64 {
+	if x != nil {
+		return x.EstimatedCost
+	}
+	return 0
+}
+
+func (x *EstimateQueryResponse) GetLimitBytes() int64 {
+	if x != nil {
+		return x.LimitBytes
+	}
+	return 0
+}
+
+func (x *EstimateQueryResponse) GetExceedsLimit() bool {
+	if x != nil {
+		return x.ExceedsLimit
+	}
+	return false
+}
+
+type CancelQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueryId string `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+}
+
+func (x *CancelQueryRequest) Reset() {
+	*x = CancelQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelQueryRequest) ProtoMessage() {}
+
+func (x *CancelQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelQueryRequest.ProtoReflect.Descriptor instead.
+func (*CancelQueryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CancelQueryRequest) GetQueryId() string {
+	if x != nil {
+		return x.QueryId
+	}
+	return ""
+}
+
+type CancelQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelQueryResponse) Reset() {
+	*x = CancelQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelQueryResponse) ProtoMessage() {}
+
+func (x *CancelQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelQueryResponse.ProtoReflect.Descriptor instead.
+func (*CancelQueryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{62}
+}
+
+type CreateDatasetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+}
+
+func (x *CreateDatasetRequest) Reset() {
+	*x = CreateDatasetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDatasetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDatasetRequest) ProtoMessage() {}
+
+func (x *CreateDatasetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDatasetRequest.ProtoReflect.Descriptor instead.
+func (*CreateDatasetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateDatasetRequest) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+type CreateDatasetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateDatasetResponse) Reset() {
+	*x = CreateDatasetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDatasetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDatasetResponse) ProtoMessage() {}
+
+func (x *CreateDatasetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDatasetResponse.ProtoReflect.Descriptor instead.
+func (*CreateDatasetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{64}
+}
+
+type CreateFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+}
+
+func (x *CreateFileRequest) Reset() {
+	*x = CreateFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFileRequest) ProtoMessage() {}
+
+func (x *CreateFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFileRequest.ProtoReflect.Descriptor instead.
+func (*CreateFileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateFileRequest) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+type CreateFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+}
+
+func (x *CreateFileResponse) Reset() {
+	*x = CreateFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFileResponse) ProtoMessage() {}
+
+func (x *CreateFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFileResponse.ProtoReflect.Descriptor instead.
+func (*CreateFileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateFileResponse) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+type CreateQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatasetId    string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	DatasourceId string `protobuf:"bytes,2,opt,name=datasource_id,json=datasourceId,proto3" json:"datasource_id,omitempty"` // empty means default datasource
+}
+
+func (x *CreateQueryRequest) Reset() {
+	*x = CreateQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQueryRequest) ProtoMessage() {}
+
+func (x *CreateQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQueryRequest.ProtoReflect.Descriptor instead.
+func (*CreateQueryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateQueryRequest) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+func (x *CreateQueryRequest) GetDatasourceId() string {
+	if x != nil {
+		return x.DatasourceId
+	}
+	return ""
+}
+
+type CreateQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query *Query `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *CreateQueryResponse) Reset() {
+	*x = CreateQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQueryResponse) ProtoMessage() {}
+
+func (x *CreateQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQueryResponse.ProtoReflect.Descriptor instead.
+func (*CreateQueryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateQueryResponse) GetQuery() *Query {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type ReportStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report        *Report        `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	StreamOptions *StreamOptions `protobuf:"bytes,2,opt,name=stream_options,json=streamOptions,proto3" json:"stream_options,omitempty"`
+}
+
+func (x *ReportStreamRequest) Reset() {
+	*x = ReportStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportStreamRequest) ProtoMessage() {}
+
+func (x *ReportStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportStreamRequest.ProtoReflect.Descriptor instead.
+func (*ReportStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReportStreamRequest) GetReport() *Report {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *ReportStreamRequest) GetStreamOptions() *StreamOptions {
+	if x != nil {
+		return x.StreamOptions
+	}
+	return nil
+}
+
+type ReportStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report        *Report        `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Queries       []*Query       `protobuf:"bytes,2,rep,name=queries,proto3" json:"queries,omitempty"`
+	StreamOptions *StreamOptions `protobuf:"bytes,3,opt,name=stream_options,json=streamOptions,proto3" json:"stream_options,omitempty"`
+	Datasets      []*Dataset     `protobuf:"bytes,4,rep,name=datasets,proto3" json:"datasets,omitempty"`
+	Files         []*File        `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *ReportStreamResponse) Reset() {
+	*x = ReportStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportStreamResponse) ProtoMessage() {}
+
+func (x *ReportStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportStreamResponse.ProtoReflect.Descriptor instead.
+func (*ReportStreamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ReportStreamResponse) GetReport() *Report {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *ReportStreamResponse) GetQueries() []*Query {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+func (x *ReportStreamResponse) GetStreamOptions() *StreamOptions {
+	if x != nil {
+		return x.StreamOptions
+	}
+	return nil
+}
+
+func (x *ReportStreamResponse) GetDatasets() []*Dataset {
+	if x != nil {
+		return x.Datasets
+	}
+	return nil
+}
+
+func (x *ReportStreamResponse) GetFiles() []*File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type ForkReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+}
+
+func (x *ForkReportRequest) Reset() {
+	*x = ForkReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForkReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkReportRequest) ProtoMessage() {}
+
+func (x *ForkReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkReportRequest.ProtoReflect.Descriptor instead.
+func (*ForkReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ForkReportRequest) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+type ForkReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+}
+
+func (x *ForkReportResponse) Reset() {
+	*x = ForkReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForkReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkReportResponse) ProtoMessage() {}
+
+func (x *ForkReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkReportResponse.ProtoReflect.Descriptor instead.
+func (*ForkReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ForkReportResponse) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+type CreateReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateReportRequest) Reset() {
+	*x = CreateReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReportRequest) ProtoMessage() {}
+
+func (x *CreateReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReportRequest.ProtoReflect.Descriptor instead.
+func (*CreateReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{73}
+}
+
+type CreateReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report *Report `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *CreateReportResponse) Reset() {
+	*x = CreateReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReportResponse) ProtoMessage() {}
+
+func (x *CreateReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReportResponse.ProtoReflect.Descriptor instead.
+func (*CreateReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateReportResponse) GetReport() *Report {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type GetEnvResponse_Variable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  GetEnvResponse_Variable_Type `protobuf:"varint,1,opt,name=type,proto3,enum=GetEnvResponse_Variable_Type" json:"type,omitempty"`
+	Value string                       `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *GetEnvResponse_Variable) Reset() {
+	*x = GetEnvResponse_Variable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dekart_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEnvResponse_Variable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnvResponse_Variable) ProtoMessage() {}
+
+func (x *GetEnvResponse_Variable) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dekart_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnvResponse_Variable.ProtoReflect.Descriptor instead.
+func (*GetEnvResponse_Variable) Descriptor() ([]byte, []int) {
+	return file_proto_dekart_proto_rawDescGZIP(), []int{44, 0}
+}
+
+func (x *GetEnvResponse_Variable) GetType() GetEnvResponse_Variable_Type {
+	if x != nil {
+		return x.Type
+	}
+	return GetEnvResponse_Variable_TYPE_UNSPECIFIED
+}
+
+func (x *GetEnvResponse_Variable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_proto_dekart_proto protoreflect.FileDescriptor
+
+var file_proto_dekart_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x65, 0x6b, 0x61, 0x72, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x87, 0x01, 0x0a, 0x08, 0x41, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2b,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x56, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x61, 0x70, 0x69, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x08, 0x61, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x09, 0x61, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x39, 0x0a, 0x15,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x70, 0x69, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xf3, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x2a, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3e, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x10, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x56, 0x49,
+	0x45, 0x57, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x45,
+	0x44, 0x49, 0x54, 0x4f, 0x52, 0x10, 0x02, 0x22, 0xd6, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x5f, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a,
+	0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12,
+	0x0d, 0x0a, 0x09, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x10,
+	0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x04,
+	0x22, 0x6b, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x1d, 0x0a,
+	0x1b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7f, 0x0a,
+	0x1a, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x1d,
+	0x0a, 0x1b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe2, 0x02,
+	0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4d,
+	0x0a, 0x10, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a,
+	0x41, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xc4, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x50, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x14, 0x0a, 0x10, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x44,
+	0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45,
+	0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x03, 0x22, 0x38, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x89, 0x01, 0x0a, 0x1a, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x74, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x1b,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x1c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x1d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x9d, 0x02, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75,
+	0x6e, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74,
+	0x52, 0x75, 0x6e, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75,
+	0x6e, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x52, 0x75, 0x6e, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x32, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x16,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x66, 0x0a,
+	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x3f, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x25, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x38, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64,
+	0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa2, 0x01,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc8, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x28, 0x0a,
+	0x10, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x62,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f,
+	0x62, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x73, 0x12,
+	0x3d, 0x0a, 0x1b, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x5f, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x54, 0x6f, 0x64,
+	0x61, 0x79, 0x12, 0x3c, 0x0a, 0x1b, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79,
+	0x22, 0x59, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x53,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e,
+	0x6b, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x22,
+	0x18, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x14, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64,
+	0x22, 0x36, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe5, 0x03, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x12, 0x2d, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x1a, 0xeb, 0x02, 0x0a, 0x08, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x31, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x95, 0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41,
+	0x50, 0x42, 0x4f, 0x58, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x58, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x44, 0x4f, 0x43,
+	0x55, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x58, 0x5f, 0x48, 0x4f, 0x4d, 0x45, 0x50, 0x41, 0x47, 0x45,
+	0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57,
+	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x04, 0x12, 0x13,
+	0x0a, 0x0f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52,
+	0x41, 0x47, 0x45, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x49, 0x52, 0x45, 0x5f, 0x41, 0x4d, 0x41, 0x5a, 0x4f, 0x4e, 0x5f, 0x4f, 0x49, 0x44,
+	0x43, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x49, 0x52, 0x45, 0x5f, 0x49, 0x41, 0x50, 0x10, 0x08, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x55, 0x53, 0x41, 0x47, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x53, 0x10, 0x09, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x5f, 0x4f, 0x49, 0x44, 0x43, 0x10, 0x0a, 0x22, 0x30,
+	0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x4d, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x22,
+	0x17, 0x0a, 0x15, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6e, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x07, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x35, 0x0a,
+	0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xbf, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x6e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x68, 0x61, 0x72,
+	0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x53,
+	0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x22, 0xa8, 0x01, 0x0a, 0x07, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49,
+	0x64, 0x22, 0xcf, 0x06, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x6a, 0x6f,
+	0x62, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x09, 0x6a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6a,
+	0x6f, 0x62, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x62, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x6a, 0x6f, 0x62, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6a, 0x6f, 0x62, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x6a,
+	0x6f, 0x62, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xa8, 0x01, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1a, 0x0a, 0x16, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16,
+	0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x5f,
+	0x4c, 0x45, 0x47, 0x41, 0x43, 0x59, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x4a, 0x4f, 0x42, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x52,
+	0x45, 0x53, 0x55, 0x4c, 0x54, 0x53, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x4f, 0x42, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x05, 0x22, 0x5e, 0x0a,
+	0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x18,
+	0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x51, 0x55,
+	0x45, 0x52, 0x59, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x4c, 0x49, 0x4e,
+	0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x10, 0x02, 0x4a, 0x04, 0x08,
+	0x10, 0x10, 0x11, 0x22, 0x89, 0x04, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x0b,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0c, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x73, 0x22, 0x58, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x03, 0x22,
+	0x68, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x22, 0x54, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x07, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x1c, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22,
+	0x16, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x03, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12,
+	0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52,
+	0x6f, 0x77, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d,
+	0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x40, 0x0a,
+	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x52, 0x75, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a,
+	0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x12,
+	0x0a, 0x10, 0x52, 0x75, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xfb, 0x01, 0x0a, 0x14, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xad, 0x01, 0x0a, 0x15, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x78, 0x63, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x2f, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49,
+	0x64, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x17, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x58, 0x0a, 0x12, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x6d, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x07, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x35, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x07, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x20, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73,
+	0x12, 0x1b, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x30, 0x0a,
+	0x11, 0x46, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22,
+	0x31, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x14, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x07, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x32, 0xa8, 0x10, 0x0a, 0x06, 0x44, 0x65, 0x6b, 0x61, 0x72, 0x74, 0x12, 0x3d, 0x0a,
+	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a,
+	0x46, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x2e, 0x46, 0x6f, 0x72,
+	0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x15, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x53, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43,
+	0x0a, 0x0e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x16, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69,
+	0x6e, 0x6b, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08,
+	0x52, 0x75, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x10, 0x2e, 0x52, 0x75, 0x6e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52, 0x75, 0x6e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3a, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x13,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a,
+	0x06, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x12, 0x0e, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x14, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x42,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x31, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x12, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x16,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x44, 0x69, 0x66, 0x66, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x15, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x52, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70,
+	0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x41, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x41, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x2e, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x09, 0x5a,
+	0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_dekart_proto_rawDescOnce sync.Once
+	file_proto_dekart_proto_rawDescData = file_proto_dekart_proto_rawDesc
+)
+
+func file_proto_dekart_proto_rawDescGZIP() []byte {
+	file_proto_dekart_proto_rawDescOnce.Do(func() {
+		file_proto_dekart_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_dekart_proto_rawDescData)
+	})
+	return file_proto_dekart_proto_rawDescData
+}
+
+var file_proto_dekart_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_proto_dekart_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
+var file_proto_dekart_proto_goTypes = []interface{}{
+	(ReportPermission_Role)(0),            // 0: ReportPermission.Role
+	(ReportParameter_Type)(0),             // 1: ReportParameter.Type
+	(RevisionChange_Kind)(0),              // 2: RevisionChange.Kind
+	(GetEnvResponse_Variable_Type)(0),     // 3: GetEnvResponse.Variable.Type
+	(Query_JobStatus)(0),                  // 4: Query.JobStatus
+	(Query_QuerySource)(0),                // 5: Query.QuerySource
+	(File_Status)(0),                      // 6: File.Status
+	(*ApiToken)(nil),                      // 7: ApiToken
+	(*CreateApiTokenRequest)(nil),         // 8: CreateApiTokenRequest
+	(*CreateApiTokenResponse)(nil),        // 9: CreateApiTokenResponse
+	(*GetApiTokensRequest)(nil),           // 10: GetApiTokensRequest
+	(*GetApiTokensResponse)(nil),          // 11: GetApiTokensResponse
+	(*RevokeApiTokenRequest)(nil),         // 12: RevokeApiTokenRequest
+	(*RevokeApiTokenResponse)(nil),        // 13: RevokeApiTokenResponse
+	(*ReportPermission)(nil),              // 14: ReportPermission
+	(*ReportParameter)(nil),               // 15: ReportParameter
+	(*SetReportParametersRequest)(nil),    // 16: SetReportParametersRequest
+	(*SetReportParametersResponse)(nil),   // 17: SetReportParametersResponse
+	(*GetReportPermissionsRequest)(nil),   // 18: GetReportPermissionsRequest
+	(*GetReportPermissionsResponse)(nil),  // 19: GetReportPermissionsResponse
+	(*SetReportPermissionRequest)(nil),    // 20: SetReportPermissionRequest
+	(*SetReportPermissionResponse)(nil),   // 21: SetReportPermissionResponse
+	(*ReportRevision)(nil),                // 22: ReportRevision
+	(*RevisionChange)(nil),                // 23: RevisionChange
+	(*GetReportRevisionsRequest)(nil),     // 24: GetReportRevisionsRequest
+	(*GetReportRevisionsResponse)(nil),    // 25: GetReportRevisionsResponse
+	(*DiffReportRevisionsRequest)(nil),    // 26: DiffReportRevisionsRequest
+	(*DiffReportRevisionsResponse)(nil),   // 27: DiffReportRevisionsResponse
+	(*RestoreReportRevisionRequest)(nil),  // 28: RestoreReportRevisionRequest
+	(*RestoreReportRevisionResponse)(nil), // 29: RestoreReportRevisionResponse
+	(*Schedule)(nil),                      // 30: Schedule
+	(*GetSchedulesRequest)(nil),           // 31: GetSchedulesRequest
+	(*GetSchedulesResponse)(nil),          // 32: GetSchedulesResponse
+	(*CreateScheduleRequest)(nil),         // 33: CreateScheduleRequest
+	(*CreateScheduleResponse)(nil),        // 34: CreateScheduleResponse
+	(*UpdateScheduleRequest)(nil),         // 35: UpdateScheduleRequest
+	(*UpdateScheduleResponse)(nil),        // 36: UpdateScheduleResponse
+	(*DeleteScheduleRequest)(nil),         // 37: DeleteScheduleRequest
+	(*DeleteScheduleResponse)(nil),        // 38: DeleteScheduleResponse
+	(*GetUsageRequest)(nil),               // 39: GetUsageRequest
+	(*GetUsageResponse)(nil),              // 40: GetUsageResponse
+	(*GetQuotaRequest)(nil),               // 41: GetQuotaRequest
+	(*GetQuotaResponse)(nil),              // 42: GetQuotaResponse
+	(*SetDiscoverableRequest)(nil),        // 43: SetDiscoverableRequest
+	(*SetDiscoverableResponse)(nil),       // 44: SetDiscoverableResponse
+	(*SetLinkSharingRequest)(nil),         // 45: SetLinkSharingRequest
+	(*SetLinkSharingResponse)(nil),        // 46: SetLinkSharingResponse
+	(*RemoveDatasetRequest)(nil),          // 47: RemoveDatasetRequest
+	(*RemoveDatasetResponse)(nil),         // 48: RemoveDatasetResponse
+	(*StreamOptions)(nil),                 // 49: StreamOptions
+	(*GetEnvRequest)(nil),                 // 50: GetEnvRequest
+	(*GetEnvResponse)(nil),                // 51: GetEnvResponse
+	(*Datasource)(nil),                    // 52: Datasource
+	(*ArchiveReportRequest)(nil),          // 53: ArchiveReportRequest
+	(*ArchiveReportResponse)(nil),         // 54: ArchiveReportResponse
+	(*ReportListRequest)(nil),             // 55: ReportListRequest
+	(*ReportListResponse)(nil),            // 56: ReportListResponse
+	(*Report)(nil),                        // 57: Report
+	(*Dataset)(nil),                       // 58: Dataset
+	(*Query)(nil),                         // 59: Query
+	(*File)(nil),                          // 60: File
+	(*Column)(nil),                        // 61: Column
+	(*UpdateReportRequest)(nil),           // 62: UpdateReportRequest
+	(*UpdateReportResponse)(nil),          // 63: UpdateReportResponse
+	(*RunQueryRequest)(nil),               // 64: RunQueryRequest
+	(*RunQueryResponse)(nil),              // 65: RunQueryResponse
+	(*EstimateQueryRequest)(nil),          // 66: EstimateQueryRequest
+	(*EstimateQueryResponse)(nil),         // 67: EstimateQueryResponse
+	(*CancelQueryRequest)(nil),            // 68: CancelQueryRequest
+	(*CancelQueryResponse)(nil),           // 69: CancelQueryResponse
+	(*CreateDatasetRequest)(nil),          // 70: CreateDatasetRequest
+	(*CreateDatasetResponse)(nil),         // 71: CreateDatasetResponse
+	(*CreateFileRequest)(nil),             // 72: CreateFileRequest
+	(*CreateFileResponse)(nil),            // 73: CreateFileResponse
+	(*CreateQueryRequest)(nil),            // 74: CreateQueryRequest
+	(*CreateQueryResponse)(nil),           // 75: CreateQueryResponse
+	(*ReportStreamRequest)(nil),           // 76: ReportStreamRequest
+	(*ReportStreamResponse)(nil),          // 77: ReportStreamResponse
+	(*ForkReportRequest)(nil),             // 78: ForkReportRequest
+	(*ForkReportResponse)(nil),            // 79: ForkReportResponse
+	(*CreateReportRequest)(nil),           // 80: CreateReportRequest
+	(*CreateReportResponse)(nil),          // 81: CreateReportResponse
+	nil,                                   // 82: ReportRevision.QuerySourceIdsEntry
+	(*GetEnvResponse_Variable)(nil),       // 83: GetEnvResponse.Variable
+	nil,                                   // 84: RunQueryRequest.ParametersEntry
+	nil,                                   // 85: EstimateQueryRequest.ParametersEntry
+}
+var file_proto_dekart_proto_depIdxs = []int32{
+	7,  // 0: CreateApiTokenResponse.api_token:type_name -> ApiToken
+	7,  // 1: GetApiTokensResponse.api_tokens:type_name -> ApiToken
+	0,  // 2: ReportPermission.role:type_name -> ReportPermission.Role
+	1,  // 3: ReportParameter.type:type_name -> ReportParameter.Type
+	15, // 4: SetReportParametersRequest.parameters:type_name -> ReportParameter
+	14, // 5: GetReportPermissionsResponse.permissions:type_name -> ReportPermission
+	0,  // 6: SetReportPermissionRequest.role:type_name -> ReportPermission.Role
+	82, // 7: ReportRevision.query_source_ids:type_name -> ReportRevision.QuerySourceIdsEntry
+	2,  // 8: RevisionChange.kind:type_name -> RevisionChange.Kind
+	22, // 9: GetReportRevisionsResponse.revisions:type_name -> ReportRevision
+	23, // 10: DiffReportRevisionsResponse.changes:type_name -> RevisionChange
+	22, // 11: RestoreReportRevisionResponse.revision:type_name -> ReportRevision
+	30, // 12: GetSchedulesResponse.schedules:type_name -> Schedule
+	30, // 13: CreateScheduleResponse.schedule:type_name -> Schedule
+	30, // 14: UpdateScheduleResponse.schedule:type_name -> Schedule
+	83, // 15: GetEnvResponse.variables:type_name -> GetEnvResponse.Variable
+	52, // 16: GetEnvResponse.datasources:type_name -> Datasource
+	49, // 17: ReportListRequest.stream_options:type_name -> StreamOptions
+	57, // 18: ReportListResponse.reports:type_name -> Report
+	49, // 19: ReportListResponse.stream_options:type_name -> StreamOptions
+	15, // 20: Report.parameters:type_name -> ReportParameter
+	4,  // 21: Query.job_status:type_name -> Query.JobStatus
+	5,  // 22: Query.query_source:type_name -> Query.QuerySource
+	61, // 23: Query.schema:type_name -> Column
+	6,  // 24: File.file_status:type_name -> File.Status
+	61, // 25: File.schema:type_name -> Column
+	57, // 26: UpdateReportRequest.report:type_name -> Report
+	59, // 27: UpdateReportRequest.query:type_name -> Query
+	84, // 28: RunQueryRequest.parameters:type_name -> RunQueryRequest.ParametersEntry
+	85, // 29: EstimateQueryRequest.parameters:type_name -> EstimateQueryRequest.ParametersEntry
+	59, // 30: CreateQueryResponse.query:type_name -> Query
+	57, // 31: ReportStreamRequest.report:type_name -> Report
+	49, // 32: ReportStreamRequest.stream_options:type_name -> StreamOptions
+	57, // 33: ReportStreamResponse.report:type_name -> Report
+	59, // 34: ReportStreamResponse.queries:type_name -> Query
+	49, // 35: ReportStreamResponse.stream_options:type_name -> StreamOptions
+	58, // 36: ReportStreamResponse.datasets:type_name -> Dataset
+	60, // 37: ReportStreamResponse.files:type_name -> File
+	57, // 38: CreateReportResponse.report:type_name -> Report
+	3,  // 39: GetEnvResponse.Variable.type:type_name -> GetEnvResponse.Variable.Type
+	80, // 40: Dekart.CreateReport:input_type -> CreateReportRequest
+	78, // 41: Dekart.ForkReport:input_type -> ForkReportRequest
+	62, // 42: Dekart.UpdateReport:input_type -> UpdateReportRequest
+	53, // 43: Dekart.ArchiveReport:input_type -> ArchiveReportRequest
+	43, // 44: Dekart.SetDiscoverable:input_type -> SetDiscoverableRequest
+	45, // 45: Dekart.SetLinkSharing:input_type -> SetLinkSharingRequest
+	70, // 46: Dekart.CreateDataset:input_type -> CreateDatasetRequest
+	47, // 47: Dekart.RemoveDataset:input_type -> RemoveDatasetRequest
+	72, // 48: Dekart.CreateFile:input_type -> CreateFileRequest
+	74, // 49: Dekart.CreateQuery:input_type -> CreateQueryRequest
+	64, // 50: Dekart.RunQuery:input_type -> RunQueryRequest
+	68, // 51: Dekart.CancelQuery:input_type -> CancelQueryRequest
+	66, // 52: Dekart.EstimateQuery:input_type -> EstimateQueryRequest
+	50, // 53: Dekart.GetEnv:input_type -> GetEnvRequest
+	76, // 54: Dekart.GetReportStream:input_type -> ReportStreamRequest
+	55, // 55: Dekart.GetReportListStream:input_type -> ReportListRequest
+	39, // 56: Dekart.GetUsage:input_type -> GetUsageRequest
+	41, // 57: Dekart.GetQuota:input_type -> GetQuotaRequest
+	31, // 58: Dekart.GetSchedules:input_type -> GetSchedulesRequest
+	33, // 59: Dekart.CreateSchedule:input_type -> CreateScheduleRequest
+	35, // 60: Dekart.UpdateSchedule:input_type -> UpdateScheduleRequest
+	37, // 61: Dekart.DeleteSchedule:input_type -> DeleteScheduleRequest
+	24, // 62: Dekart.GetReportRevisions:input_type -> GetReportRevisionsRequest
+	26, // 63: Dekart.DiffReportRevisions:input_type -> DiffReportRevisionsRequest
+	28, // 64: Dekart.RestoreReportRevision:input_type -> RestoreReportRevisionRequest
+	18, // 65: Dekart.GetReportPermissions:input_type -> GetReportPermissionsRequest
+	20, // 66: Dekart.SetReportPermission:input_type -> SetReportPermissionRequest
+	16, // 67: Dekart.SetReportParameters:input_type -> SetReportParametersRequest
+	8,  // 68: Dekart.CreateApiToken:input_type -> CreateApiTokenRequest
+	10, // 69: Dekart.GetApiTokens:input_type -> GetApiTokensRequest
+	12, // 70: Dekart.RevokeApiToken:input_type -> RevokeApiTokenRequest
+	81, // 71: Dekart.CreateReport:output_type -> CreateReportResponse
+	79, // 72: Dekart.ForkReport:output_type -> ForkReportResponse
+	63, // 73: Dekart.UpdateReport:output_type -> UpdateReportResponse
+	54, // 74: Dekart.ArchiveReport:output_type -> ArchiveReportResponse
+	44, // 75: Dekart.SetDiscoverable:output_type -> SetDiscoverableResponse
+	46, // 76: Dekart.SetLinkSharing:output_type -> SetLinkSharingResponse
+	71, // 77: Dekart.CreateDataset:output_type -> CreateDatasetResponse
+	48, // 78: Dekart.RemoveDataset:output_type -> RemoveDatasetResponse
+	73, // 79: Dekart.CreateFile:output_type -> CreateFileResponse
+	75, // 80: Dekart.CreateQuery:output_type -> CreateQueryResponse
+	65, // 81: Dekart.RunQuery:output_type -> RunQueryResponse
+	69, // 82: Dekart.CancelQuery:output_type -> CancelQueryResponse
+	67, // 83: Dekart.EstimateQuery:output_type -> EstimateQueryResponse
+	51, // 84: Dekart.GetEnv:output_type -> GetEnvResponse
+	77, // 85: Dekart.GetReportStream:output_type -> ReportStreamResponse
+	56, // 86: Dekart.GetReportListStream:output_type -> ReportListResponse
+	40, // 87: Dekart.GetUsage:output_type -> GetUsageResponse
+	42, // 88: Dekart.GetQuota:output_type -> GetQuotaResponse
+	32, // 89: Dekart.GetSchedules:output_type -> GetSchedulesResponse
+	34, // 90: Dekart.CreateSchedule:output_type -> CreateScheduleResponse
+	36, // 91: Dekart.UpdateSchedule:output_type -> UpdateScheduleResponse
+	38, // 92: Dekart.DeleteSchedule:output_type -> DeleteScheduleResponse
+	25, // 93: Dekart.GetReportRevisions:output_type -> GetReportRevisionsResponse
+	27, // 94: Dekart.DiffReportRevisions:output_type -> DiffReportRevisionsResponse
+	29, // 95: Dekart.RestoreReportRevision:output_type -> RestoreReportRevisionResponse
+	19, // 96: Dekart.GetReportPermissions:output_type -> GetReportPermissionsResponse
+	21, // 97: Dekart.SetReportPermission:output_type -> SetReportPermissionResponse
+	17, // 98: Dekart.SetReportParameters:output_type -> SetReportParametersResponse
+	9,  // 99: Dekart.CreateApiToken:output_type -> CreateApiTokenResponse
+	11, // 100: Dekart.GetApiTokens:output_type -> GetApiTokensResponse
+	13, // 101: Dekart.RevokeApiToken:output_type -> RevokeApiTokenResponse
+	71, // [71:102] is the sub-list for method output_type
+	40, // [40:71] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
+}
+
+func init() { file_proto_dekart_proto_init() }
+func file_proto_dekart_proto_init() {
+	if File_proto_dekart_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_dekart_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateApiTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateApiTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApiTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApiTokensResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeApiTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeApiTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportPermission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReportParametersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReportParametersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReportPermissionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReportPermissionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReportPermissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReportPermissionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevisionChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReportRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReportRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffReportRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffReportRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreReportRevisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreReportRevisionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Schedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetEnvResponse_Variable.ProtoReflect.Descriptor instead.
-func (*GetEnvResponse_Variable) Descriptor() ([]byte, []int) {
-	return file_proto_dekart_proto_rawDescGZIP(), []int{8, 0}
-}
-
-func (x *GetEnvResponse_Variable) GetType() GetEnvResponse_Variable_Type {
-	if x != nil {
-		return x.Type
-	}
-	return GetEnvResponse_Variable_TYPE_UNSPECIFIED
-}
-
-func (x *GetEnvResponse_Variable) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
-}
-
-var File_proto_dekart_proto protoreflect.FileDescriptor
-
-var file_proto_dekart_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x65, 0x6b, 0x61, 0x72, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x69,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x51,
-	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x22, 0x59, 0x0a, 0x16,
-	0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x44, 0x69,
-	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x35, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x15, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49,
-	0x64, 0x22, 0x2b, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x0f,
-	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x9f, 0x03, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0xd4, 0x02, 0x0a, 0x08, 0x56,
-	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0xfe, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x15, 0x0a, 0x11, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x50, 0x42, 0x4f, 0x58, 0x5f, 0x54,
-	0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x58, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x44, 0x4f, 0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x58, 0x5f, 0x48, 0x4f, 0x4d, 0x45, 0x50, 0x41, 0x47, 0x45, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f,
-	0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x44, 0x41, 0x54, 0x41, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x05, 0x12, 0x10, 0x0a,
-	0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x10, 0x06, 0x12,
-	0x1c, 0x0a, 0x18, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x5f,
-	0x41, 0x4d, 0x41, 0x5a, 0x4f, 0x4e, 0x5f, 0x4f, 0x49, 0x44, 0x43, 0x10, 0x07, 0x12, 0x14, 0x0a,
-	0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x5f, 0x49, 0x41,
-	0x50, 0x10, 0x08, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x41,
-	0x42, 0x4c, 0x45, 0x5f, 0x55, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x10,
-	0x09, 0x22, 0x4d, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x22, 0x17, 0x0a, 0x15, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x0a, 0x11, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35,
-	0x0a, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6e, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x07, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x35,
-	0x0a, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x6e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x07, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64,
-	0x22, 0xdc, 0x05, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x6a, 0x6f, 0x62,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x09, 0x6a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6a, 0x6f,
-	0x62, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x6a, 0x6f, 0x62, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6a,
-	0x6f, 0x62, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x6a, 0x6f, 0x62, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
-	0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x27, 0x0a,
-	0x0f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
-	0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0f,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x64, 0x22, 0xa8, 0x01, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16,
-	0x0a, 0x12, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e,
-	0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1a,
-	0x0a, 0x16, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x4f, 0x4e,
-	0x45, 0x5f, 0x4c, 0x45, 0x47, 0x41, 0x43, 0x59, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x4a, 0x4f,
-	0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x47,
-	0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x53, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x4f,
-	0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x05, 0x22,
-	0x5e, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c,
-	0x0a, 0x18, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
-	0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x4c,
-	0x49, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x53,
-	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x10, 0x02, 0x22,
-	0xe2, 0x02, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e,
-	0x46, 0x69, 0x6c, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x66, 0x69, 0x6c,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x58, 0x0a, 0x06, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10,
-	0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x4f, 0x52,
-	0x45, 0x44, 0x10, 0x03, 0x22, 0x54, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x05,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x4b, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x22,
-	0x12, 0x0a, 0x10, 0x52, 0x75, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x14, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64,
-	0x22, 0x17, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a, 0x11, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x2d, 0x0a,
-	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x12,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49,
-	0x64, 0x22, 0x33, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x6d, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x35,
-	0x0a, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
-	0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07,
-	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
-	0x20, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x06, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65,
-	0x73, 0x12, 0x35, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x73, 0x65, 0x74, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x1b,
-	0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x11, 0x46,
-	0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x31, 0x0a,
-	0x12, 0x46, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64,
-	0x22, 0x15, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1f, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x07, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x32, 0x99, 0x07, 0x0a, 0x06, 0x44, 0x65, 0x6b, 0x61, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x15, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x46, 0x6f,
-	0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x46,
-	0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x14, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x15, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x15, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
-	0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12,
-	0x15, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44,
-	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x37, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x10, 0x2e, 0x52, 0x75, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52, 0x75, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x13, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x12, 0x0e,
-	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
-	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x42, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x12, 0x14, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x2e, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x13, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x08, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x09, 0x5a, 0x07,
-	0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_proto_dekart_proto_rawDescOnce sync.Once
-	file_proto_dekart_proto_rawDescData = file_proto_dekart_proto_rawDesc
-)
-
-func file_proto_dekart_proto_rawDescGZIP() []byte {
-	file_proto_dekart_proto_rawDescOnce.Do(func() {
-		file_proto_dekart_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_dekart_proto_rawDescData)
-	})
-	return file_proto_dekart_proto_rawDescData
-}
-
-var file_proto_dekart_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_proto_dekart_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
-var file_proto_dekart_proto_goTypes = []interface{}{
-	(GetEnvResponse_Variable_Type)(0), // 0: GetEnvResponse.Variable.Type
-	(Query_JobStatus)(0),              // 1: Query.JobStatus
-	(Query_QuerySource)(0),            // 2: Query.QuerySource
-	(File_Status)(0),                  // 3: File.Status
-	(*GetUsageRequest)(nil),           // 4: GetUsageRequest
-	(*GetUsageResponse)(nil),          // 5: GetUsageResponse
-	(*SetDiscoverableRequest)(nil),    // 6: SetDiscoverableRequest
-	(*SetDiscoverableResponse)(nil),   // 7: SetDiscoverableResponse
-	(*RemoveDatasetRequest)(nil),      // 8: RemoveDatasetRequest
-	(*RemoveDatasetResponse)(nil),     // 9: RemoveDatasetResponse
-	(*StreamOptions)(nil),             // 10: StreamOptions
-	(*GetEnvRequest)(nil),             // 11: GetEnvRequest
-	(*GetEnvResponse)(nil),            // 12: GetEnvResponse
-	(*ArchiveReportRequest)(nil),      // 13: ArchiveReportRequest
-	(*ArchiveReportResponse)(nil),     // 14: ArchiveReportResponse
-	(*ReportListRequest)(nil),         // 15: ReportListRequest
-	(*ReportListResponse)(nil),        // 16: ReportListResponse
-	(*Report)(nil),                    // 17: Report
-	(*Dataset)(nil),                   // 18: Dataset
-	(*Query)(nil),                     // 19: Query
-	(*File)(nil),                      // 20: File
-	(*UpdateReportRequest)(nil),       // 21: UpdateReportRequest
-	(*UpdateReportResponse)(nil),      // 22: UpdateReportResponse
-	(*RunQueryRequest)(nil),           // 23: RunQueryRequest
-	(*RunQueryResponse)(nil),          // 24: RunQueryResponse
-	(*CancelQueryRequest)(nil),        // 25: CancelQueryRequest
-	(*CancelQueryResponse)(nil),       // 26: CancelQueryResponse
-	(*CreateDatasetRequest)(nil),      // 27: CreateDatasetRequest
-	(*CreateDatasetResponse)(nil),     // 28: CreateDatasetResponse
-	(*CreateFileRequest)(nil),         // 29: CreateFileRequest
-	(*CreateFileResponse)(nil),        // 30: CreateFileResponse
-	(*CreateQueryRequest)(nil),        // 31: CreateQueryRequest
-	(*CreateQueryResponse)(nil),       // 32: CreateQueryResponse
-	(*ReportStreamRequest)(nil),       // 33: ReportStreamRequest
-	(*ReportStreamResponse)(nil),      // 34: ReportStreamResponse
-	(*ForkReportRequest)(nil),         // 35: ForkReportRequest
-	(*ForkReportResponse)(nil),        // 36: ForkReportResponse
-	(*CreateReportRequest)(nil),       // 37: CreateReportRequest
-	(*CreateReportResponse)(nil),      // 38: CreateReportResponse
-	(*GetEnvResponse_Variable)(nil),   // 39: GetEnvResponse.Variable
-}
-var file_proto_dekart_proto_depIdxs = []int32{
-	39, // 0: GetEnvResponse.variables:type_name -> GetEnvResponse.Variable
-	10, // 1: ReportListRequest.stream_options:type_name -> StreamOptions
-	17, // 2: ReportListResponse.reports:type_name -> Report
-	10, // 3: ReportListResponse.stream_options:type_name -> StreamOptions
-	1,  // 4: Query.job_status:type_name -> Query.JobStatus
-	2,  // 5: Query.query_source:type_name -> Query.QuerySource
-	3,  // 6: File.file_status:type_name -> File.Status
-	17, // 7: UpdateReportRequest.report:type_name -> Report
-	19, // 8: UpdateReportRequest.query:type_name -> Query
-	19, // 9: CreateQueryResponse.query:type_name -> Query
-	17, // 10: ReportStreamRequest.report:type_name -> Report
-	10, // 11: ReportStreamRequest.stream_options:type_name -> StreamOptions
-	17, // 12: ReportStreamResponse.report:type_name -> Report
-	19, // 13: ReportStreamResponse.queries:type_name -> Query
-	10, // 14: ReportStreamResponse.stream_options:type_name -> StreamOptions
-	18, // 15: ReportStreamResponse.datasets:type_name -> Dataset
-	20, // 16: ReportStreamResponse.files:type_name -> File
-	17, // 17: CreateReportResponse.report:type_name -> Report
-	0,  // 18: GetEnvResponse.Variable.type:type_name -> GetEnvResponse.Variable.Type
-	37, // 19: Dekart.CreateReport:input_type -> CreateReportRequest
-	35, // 20: Dekart.ForkReport:input_type -> ForkReportRequest
-	21, // 21: Dekart.UpdateReport:input_type -> UpdateReportRequest
-	13, // 22: Dekart.ArchiveReport:input_type -> ArchiveReportRequest
-	6,  // 23: Dekart.SetDiscoverable:input_type -> SetDiscoverableRequest
-	27, // 24: Dekart.CreateDataset:input_type -> CreateDatasetRequest
-	8,  // 25: Dekart.RemoveDataset:input_type -> RemoveDatasetRequest
-	29, // 26: Dekart.CreateFile:input_type -> CreateFileRequest
-	31, // 27: Dekart.CreateQuery:input_type -> CreateQueryRequest
-	23, // 28: Dekart.RunQuery:input_type -> RunQueryRequest
-	25, // 29: Dekart.CancelQuery:input_type -> CancelQueryRequest
-	11, // 30: Dekart.GetEnv:input_type -> GetEnvRequest
-	33, // 31: Dekart.GetReportStream:input_type -> ReportStreamRequest
-	15, // 32: Dekart.GetReportListStream:input_type -> ReportListRequest
-	4,  // 33: Dekart.GetUsage:input_type -> GetUsageRequest
-	38, // 34: Dekart.CreateReport:output_type -> CreateReportResponse
-	36, // 35: Dekart.ForkReport:output_type -> ForkReportResponse
-	22, // 36: Dekart.UpdateReport:output_type -> UpdateReportResponse
-	14, // 37: Dekart.ArchiveReport:output_type -> ArchiveReportResponse
-	7,  // 38: Dekart.SetDiscoverable:output_type -> SetDiscoverableResponse
-	28, // 39: Dekart.CreateDataset:output_type -> CreateDatasetResponse
-	9,  // 40: Dekart.RemoveDataset:output_type -> RemoveDatasetResponse
-	30, // 41: Dekart.CreateFile:output_type -> CreateFileResponse
-	32, // 42: Dekart.CreateQuery:output_type -> CreateQueryResponse
-	24, // 43: Dekart.RunQuery:output_type -> RunQueryResponse
-	26, // 44: Dekart.CancelQuery:output_type -> CancelQueryResponse
-	12, // 45: Dekart.GetEnv:output_type -> GetEnvResponse
-	34, // 46: Dekart.GetReportStream:output_type -> ReportStreamResponse
-	16, // 47: Dekart.GetReportListStream:output_type -> ReportListResponse
-	5,  // 48: Dekart.GetUsage:output_type -> GetUsageResponse
-	34, // [34:49] is the sub-list for method output_type
-	19, // [19:34] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
-}
-
-func init() { file_proto_dekart_proto_init() }
-func file_proto_dekart_proto_init() {
-	if File_proto_dekart_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_dekart_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUsageRequest); i {
 			case 0:
 				return &v.state
@@ -2650,7 +6109,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUsageResponse); i {
 			case 0:
 				return &v.state
@@ -2662,7 +6121,31 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuotaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetDiscoverableRequest); i {
 			case 0:
 				return &v.state
@@ -2674,7 +6157,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetDiscoverableResponse); i {
 			case 0:
 				return &v.state
@@ -2686,7 +6169,31 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLinkSharingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLinkSharingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveDatasetRequest); i {
 			case 0:
 				return &v.state
@@ -2698,7 +6205,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveDatasetResponse); i {
 			case 0:
 				return &v.state
@@ -2710,7 +6217,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamOptions); i {
 			case 0:
 				return &v.state
@@ -2722,7 +6229,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEnvRequest); i {
 			case 0:
 				return &v.state
@@ -2734,7 +6241,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEnvResponse); i {
 			case 0:
 				return &v.state
@@ -2746,7 +6253,19 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Datasource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ArchiveReportRequest); i {
 			case 0:
 				return &v.state
@@ -2758,7 +6277,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ArchiveReportResponse); i {
 			case 0:
 				return &v.state
@@ -2770,7 +6289,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportListRequest); i {
 			case 0:
 				return &v.state
@@ -2782,7 +6301,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportListResponse); i {
 			case 0:
 				return &v.state
@@ -2794,7 +6313,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Report); i {
 			case 0:
 				return &v.state
@@ -2806,7 +6325,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Dataset); i {
 			case 0:
 				return &v.state
@@ -2818,7 +6337,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Query); i {
 			case 0:
 				return &v.state
@@ -2830,7 +6349,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*File); i {
 			case 0:
 				return &v.state
@@ -2842,7 +6361,19 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Column); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateReportRequest); i {
 			case 0:
 				return &v.state
@@ -2854,7 +6385,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateReportResponse); i {
 			case 0:
 				return &v.state
@@ -2866,7 +6397,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RunQueryRequest); i {
 			case 0:
 				return &v.state
@@ -2878,7 +6409,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RunQueryResponse); i {
 			case 0:
 				return &v.state
@@ -2890,7 +6421,31 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dekart_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelQueryRequest); i {
 			case 0:
 				return &v.state
@@ -2902,7 +6457,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelQueryResponse); i {
 			case 0:
 				return &v.state
@@ -2914,7 +6469,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateDatasetRequest); i {
 			case 0:
 				return &v.state
@@ -2926,7 +6481,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateDatasetResponse); i {
 			case 0:
 				return &v.state
@@ -2938,7 +6493,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateFileRequest); i {
 			case 0:
 				return &v.state
@@ -2950,7 +6505,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateFileResponse); i {
 			case 0:
 				return &v.state
@@ -2962,7 +6517,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateQueryRequest); i {
 			case 0:
 				return &v.state
@@ -2974,7 +6529,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateQueryResponse); i {
 			case 0:
 				return &v.state
@@ -2986,7 +6541,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportStreamRequest); i {
 			case 0:
 				return &v.state
@@ -2998,7 +6553,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportStreamResponse); i {
 			case 0:
 				return &v.state
@@ -3010,7 +6565,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ForkReportRequest); i {
 			case 0:
 				return &v.state
@@ -3022,7 +6577,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ForkReportResponse); i {
 			case 0:
 				return &v.state
@@ -3034,7 +6589,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateReportRequest); i {
 			case 0:
 				return &v.state
@@ -3046,7 +6601,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateReportResponse); i {
 			case 0:
 				return &v.state
@@ -3058,7 +6613,7 @@ func file_proto_dekart_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dekart_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dekart_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEnvResponse_Variable); i {
 			case 0:
 				return &v.state
@@ -3076,8 +6631,8 @@ func file_proto_dekart_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_dekart_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   36,
+			NumEnums:      7,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,6 +28,7 @@ type DekartClient interface {
 	UpdateReport(ctx context.Context, in *UpdateReportRequest, opts ...grpc.CallOption) (*UpdateReportResponse, error)
 	ArchiveReport(ctx context.Context, in *ArchiveReportRequest, opts ...grpc.CallOption) (*ArchiveReportResponse, error)
 	SetDiscoverable(ctx context.Context, in *SetDiscoverableRequest, opts ...grpc.CallOption) (*SetDiscoverableResponse, error)
+	SetLinkSharing(ctx context.Context, in *SetLinkSharingRequest, opts ...grpc.CallOption) (*SetLinkSharingResponse, error)
 	// datasets
 	CreateDataset(ctx context.Context, in *CreateDatasetRequest, opts ...grpc.CallOption) (*CreateDatasetResponse, error)
 	RemoveDataset(ctx context.Context, in *RemoveDatasetRequest, opts ...grpc.CallOption) (*RemoveDatasetResponse, error)
@@ -37,12 +38,32 @@ type DekartClient interface {
 	CreateQuery(ctx context.Context, in *CreateQueryRequest, opts ...grpc.CallOption) (*CreateQueryResponse, error)
 	RunQuery(ctx context.Context, in *RunQueryRequest, opts ...grpc.CallOption) (*RunQueryResponse, error)
 	CancelQuery(ctx context.Context, in *CancelQueryRequest, opts ...grpc.CallOption) (*CancelQueryResponse, error)
+	EstimateQuery(ctx context.Context, in *EstimateQueryRequest, opts ...grpc.CallOption) (*EstimateQueryResponse, error)
 	GetEnv(ctx context.Context, in *GetEnvRequest, opts ...grpc.CallOption) (*GetEnvResponse, error)
 	// streams
 	GetReportStream(ctx context.Context, in *ReportStreamRequest, opts ...grpc.CallOption) (Dekart_GetReportStreamClient, error)
 	GetReportListStream(ctx context.Context, in *ReportListRequest, opts ...grpc.CallOption) (Dekart_GetReportListStreamClient, error)
 	//statistics
 	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+	GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*GetQuotaResponse, error)
+	// schedules
+	GetSchedules(ctx context.Context, in *GetSchedulesRequest, opts ...grpc.CallOption) (*GetSchedulesResponse, error)
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error)
+	UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*UpdateScheduleResponse, error)
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error)
+	// report history
+	GetReportRevisions(ctx context.Context, in *GetReportRevisionsRequest, opts ...grpc.CallOption) (*GetReportRevisionsResponse, error)
+	DiffReportRevisions(ctx context.Context, in *DiffReportRevisionsRequest, opts ...grpc.CallOption) (*DiffReportRevisionsResponse, error)
+	RestoreReportRevision(ctx context.Context, in *RestoreReportRevisionRequest, opts ...grpc.CallOption) (*RestoreReportRevisionResponse, error)
+	// sharing
+	GetReportPermissions(ctx context.Context, in *GetReportPermissionsRequest, opts ...grpc.CallOption) (*GetReportPermissionsResponse, error)
+	SetReportPermission(ctx context.Context, in *SetReportPermissionRequest, opts ...grpc.CallOption) (*SetReportPermissionResponse, error)
+	// parameters
+	SetReportParameters(ctx context.Context, in *SetReportParametersRequest, opts ...grpc.CallOption) (*SetReportParametersResponse, error)
+	// api tokens
+	CreateApiToken(ctx context.Context, in *CreateApiTokenRequest, opts ...grpc.CallOption) (*CreateApiTokenResponse, error)
+	GetApiTokens(ctx context.Context, in *GetApiTokensRequest, opts ...grpc.CallOption) (*GetApiTokensResponse, error)
+	RevokeApiToken(ctx context.Context, in *RevokeApiTokenRequest, opts ...grpc.CallOption) (*RevokeApiTokenResponse, error)
 }
 
 type dekartClient struct {
@@ -98,6 +119,15 @@ func (c *dekartClient) SetDiscoverable(ctx context.Context, in *SetDiscoverableR
 	return out, nil
 }
 
+func (c *dekartClient) SetLinkSharing(ctx context.Context, in *SetLinkSharingRequest, opts ...grpc.CallOption) (*SetLinkSharingResponse, error) {
+	out := new(SetLinkSharingResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/SetLinkSharing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dekartClient) CreateDataset(ctx context.Context, in *CreateDatasetRequest, opts ...grpc.CallOption) (*CreateDatasetResponse, error) {
 	out := new(CreateDatasetResponse)
 	err := c.cc.Invoke(ctx, "/Dekart/CreateDataset", in, out, opts...)
@@ -152,6 +182,15 @@ func (c *dekartClient) CancelQuery(ctx context.Context, in *CancelQueryRequest,
 	return out, nil
 }
 
+func (c *dekartClient) EstimateQuery(ctx context.Context, in *EstimateQueryRequest, opts ...grpc.CallOption) (*EstimateQueryResponse, error) {
+	out := new(EstimateQueryResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/EstimateQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dekartClient) GetEnv(ctx context.Context, in *GetEnvRequest, opts ...grpc.CallOption) (*GetEnvResponse, error) {
 	out := new(GetEnvResponse)
 	err := c.cc.Invoke(ctx, "/Dekart/GetEnv", in, out, opts...)
@@ -234,6 +273,132 @@ func (c *dekartClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts .
 	return out, nil
 }
 
+func (c *dekartClient) GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*GetQuotaResponse, error) {
+	out := new(GetQuotaResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/GetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dekartClient) GetSchedules(ctx context.Context, in *GetSchedulesRequest, opts ...grpc.CallOption) (*GetSchedulesResponse, error) {
+	out := new(GetSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/GetSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dekartClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error) {
+	out := new(CreateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dekartClient) UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*UpdateScheduleResponse, error) {
+	out := new(UpdateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/UpdateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dekartClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error) {
+	out := new(DeleteScheduleResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/DeleteSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dekartClient) GetReportRevisions(ctx context.Context, in *GetReportRevisionsRequest, opts ...grpc.CallOption) (*GetReportRevisionsResponse, error) {
+	out := new(GetReportRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/GetReportRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dekartClient) DiffReportRevisions(ctx context.Context, in *DiffReportRevisionsRequest, opts ...grpc.CallOption) (*DiffReportRevisionsResponse, error) {
+	out := new(DiffReportRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/DiffReportRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dekartClient) RestoreReportRevision(ctx context.Context, in *RestoreReportRevisionRequest, opts ...grpc.CallOption) (*RestoreReportRevisionResponse, error) {
+	out := new(RestoreReportRevisionResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/RestoreReportRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dekartClient) GetReportPermissions(ctx context.Context, in *GetReportPermissionsRequest, opts ...grpc.CallOption) (*GetReportPermissionsResponse, error) {
+	out := new(GetReportPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/GetReportPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dekartClient) SetReportPermission(ctx context.Context, in *SetReportPermissionRequest, opts ...grpc.CallOption) (*SetReportPermissionResponse, error) {
+	out := new(SetReportPermissionResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/SetReportPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dekartClient) SetReportParameters(ctx context.Context, in *SetReportParametersRequest, opts ...grpc.CallOption) (*SetReportParametersResponse, error) {
+	out := new(SetReportParametersResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/SetReportParameters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dekartClient) CreateApiToken(ctx context.Context, in *CreateApiTokenRequest, opts ...grpc.CallOption) (*CreateApiTokenResponse, error) {
+	out := new(CreateApiTokenResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/CreateApiToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dekartClient) GetApiTokens(ctx context.Context, in *GetApiTokensRequest, opts ...grpc.CallOption) (*GetApiTokensResponse, error) {
+	out := new(GetApiTokensResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/GetApiTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dekartClient) RevokeApiToken(ctx context.Context, in *RevokeApiTokenRequest, opts ...grpc.CallOption) (*RevokeApiTokenResponse, error) {
+	out := new(RevokeApiTokenResponse)
+	err := c.cc.Invoke(ctx, "/Dekart/RevokeApiToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DekartServer is the server API for Dekart service.
 // All implementations must embed UnimplementedDekartServer
 // for forward compatibility
@@ -244,6 +409,7 @@ type DekartServer interface {
 	UpdateReport(context.Context, *UpdateReportRequest) (*UpdateReportResponse, error)
 	ArchiveReport(context.Context, *ArchiveReportRequest) (*ArchiveReportResponse, error)
 	SetDiscoverable(context.Context, *SetDiscoverableRequest) (*SetDiscoverableResponse, error)
+	SetLinkSharing(context.Context, *SetLinkSharingRequest) (*SetLinkSharingResponse, error)
 	// datasets
 	CreateDataset(context.Context, *CreateDatasetRequest) (*CreateDatasetResponse, error)
 	RemoveDataset(context.Context, *RemoveDatasetRequest) (*RemoveDatasetResponse, error)
@@ -253,12 +419,32 @@ type DekartServer interface {
 	CreateQuery(context.Context, *CreateQueryRequest) (*CreateQueryResponse, error)
 	RunQuery(context.Context, *RunQueryRequest) (*RunQueryResponse, error)
 	CancelQuery(context.Context, *CancelQueryRequest) (*CancelQueryResponse, error)
+	EstimateQuery(context.Context, *EstimateQueryRequest) (*EstimateQueryResponse, error)
 	GetEnv(context.Context, *GetEnvRequest) (*GetEnvResponse, error)
 	// streams
 	GetReportStream(*ReportStreamRequest, Dekart_GetReportStreamServer) error
 	GetReportListStream(*ReportListRequest, Dekart_GetReportListStreamServer) error
 	//statistics
 	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	GetQuota(context.Context, *GetQuotaRequest) (*GetQuotaResponse, error)
+	// schedules
+	GetSchedules(context.Context, *GetSchedulesRequest) (*GetSchedulesResponse, error)
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error)
+	UpdateSchedule(context.Context, *UpdateScheduleRequest) (*UpdateScheduleResponse, error)
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
+	// report history
+	GetReportRevisions(context.Context, *GetReportRevisionsRequest) (*GetReportRevisionsResponse, error)
+	DiffReportRevisions(context.Context, *DiffReportRevisionsRequest) (*DiffReportRevisionsResponse, error)
+	RestoreReportRevision(context.Context, *RestoreReportRevisionRequest) (*RestoreReportRevisionResponse, error)
+	// sharing
+	GetReportPermissions(context.Context, *GetReportPermissionsRequest) (*GetReportPermissionsResponse, error)
+	SetReportPermission(context.Context, *SetReportPermissionRequest) (*SetReportPermissionResponse, error)
+	// parameters
+	SetReportParameters(context.Context, *SetReportParametersRequest) (*SetReportParametersResponse, error)
+	// api tokens
+	CreateApiToken(context.Context, *CreateApiTokenRequest) (*CreateApiTokenResponse, error)
+	GetApiTokens(context.Context, *GetApiTokensRequest) (*GetApiTokensResponse, error)
+	RevokeApiToken(context.Context, *RevokeApiTokenRequest) (*RevokeApiTokenResponse, error)
 	mustEmbedUnimplementedDekartServer()
 }
 
@@ -281,6 +467,9 @@ func (UnimplementedDekartServer) ArchiveReport(context.Context, *ArchiveReportRe
 func (UnimplementedDekartServer) SetDiscoverable(context.Context, *SetDiscoverableRequest) (*SetDiscoverableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDiscoverable not implemented")
 }
+func (UnimplementedDekartServer) SetLinkSharing(context.Context, *SetLinkSharingRequest) (*SetLinkSharingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLinkSharing not implemented")
+}
 func (UnimplementedDekartServer) CreateDataset(context.Context, *CreateDatasetRequest) (*CreateDatasetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDataset not implemented")
 }
@@ -299,6 +488,9 @@ func (UnimplementedDekartServer) RunQuery(context.Context, *RunQueryRequest) (*R
 func (UnimplementedDekartServer) CancelQuery(context.Context, *CancelQueryRequest) (*CancelQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelQuery not implemented")
 }
+func (UnimplementedDekartServer) EstimateQuery(context.Context, *EstimateQueryRequest) (*EstimateQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateQuery not implemented")
+}
 func (UnimplementedDekartServer) GetEnv(context.Context, *GetEnvRequest) (*GetEnvResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnv not implemented")
 }
@@ -311,6 +503,48 @@ func (UnimplementedDekartServer) GetReportListStream(*ReportListRequest, Dekart_
 func (UnimplementedDekartServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
 }
+func (UnimplementedDekartServer) GetQuota(context.Context, *GetQuotaRequest) (*GetQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuota not implemented")
+}
+func (UnimplementedDekartServer) GetSchedules(context.Context, *GetSchedulesRequest) (*GetSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedules not implemented")
+}
+func (UnimplementedDekartServer) CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedDekartServer) UpdateSchedule(context.Context, *UpdateScheduleRequest) (*UpdateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
+func (UnimplementedDekartServer) DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (UnimplementedDekartServer) GetReportRevisions(context.Context, *GetReportRevisionsRequest) (*GetReportRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReportRevisions not implemented")
+}
+func (UnimplementedDekartServer) DiffReportRevisions(context.Context, *DiffReportRevisionsRequest) (*DiffReportRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffReportRevisions not implemented")
+}
+func (UnimplementedDekartServer) RestoreReportRevision(context.Context, *RestoreReportRevisionRequest) (*RestoreReportRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreReportRevision not implemented")
+}
+func (UnimplementedDekartServer) GetReportPermissions(context.Context, *GetReportPermissionsRequest) (*GetReportPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReportPermissions not implemented")
+}
+func (UnimplementedDekartServer) SetReportPermission(context.Context, *SetReportPermissionRequest) (*SetReportPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReportPermission not implemented")
+}
+func (UnimplementedDekartServer) SetReportParameters(context.Context, *SetReportParametersRequest) (*SetReportParametersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReportParameters not implemented")
+}
+func (UnimplementedDekartServer) CreateApiToken(context.Context, *CreateApiTokenRequest) (*CreateApiTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiToken not implemented")
+}
+func (UnimplementedDekartServer) GetApiTokens(context.Context, *GetApiTokensRequest) (*GetApiTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiTokens not implemented")
+}
+func (UnimplementedDekartServer) RevokeApiToken(context.Context, *RevokeApiTokenRequest) (*RevokeApiTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiToken not implemented")
+}
 func (UnimplementedDekartServer) mustEmbedUnimplementedDekartServer() {}
 
 // UnsafeDekartServer may be embedded to opt out of forward compatibility for this service.
@@ -414,6 +648,24 @@ func _Dekart_SetDiscoverable_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dekart_SetLinkSharing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLinkSharingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).SetLinkSharing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/SetLinkSharing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).SetLinkSharing(ctx, req.(*SetLinkSharingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dekart_CreateDataset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateDatasetRequest)
 	if err := dec(in); err != nil {
@@ -522,6 +774,24 @@ func _Dekart_CancelQuery_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dekart_EstimateQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).EstimateQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/EstimateQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).EstimateQuery(ctx, req.(*EstimateQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dekart_GetEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetEnvRequest)
 	if err := dec(in); err != nil {
@@ -600,6 +870,258 @@ func _Dekart_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dekart_GetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).GetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/GetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).GetQuota(ctx, req.(*GetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dekart_GetSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).GetSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/GetSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).GetSchedules(ctx, req.(*GetSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dekart_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/CreateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dekart_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).UpdateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/UpdateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).UpdateSchedule(ctx, req.(*UpdateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dekart_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/DeleteSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dekart_GetReportRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).GetReportRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/GetReportRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).GetReportRevisions(ctx, req.(*GetReportRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dekart_DiffReportRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffReportRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).DiffReportRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/DiffReportRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).DiffReportRevisions(ctx, req.(*DiffReportRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dekart_RestoreReportRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreReportRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).RestoreReportRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/RestoreReportRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).RestoreReportRevision(ctx, req.(*RestoreReportRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dekart_GetReportPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).GetReportPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/GetReportPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).GetReportPermissions(ctx, req.(*GetReportPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dekart_SetReportPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReportPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).SetReportPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/SetReportPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).SetReportPermission(ctx, req.(*SetReportPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dekart_SetReportParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReportParametersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).SetReportParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/SetReportParameters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).SetReportParameters(ctx, req.(*SetReportParametersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dekart_CreateApiToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).CreateApiToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/CreateApiToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).CreateApiToken(ctx, req.(*CreateApiTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dekart_GetApiTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).GetApiTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/GetApiTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).GetApiTokens(ctx, req.(*GetApiTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dekart_RevokeApiToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DekartServer).RevokeApiToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dekart/RevokeApiToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DekartServer).RevokeApiToken(ctx, req.(*RevokeApiTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dekart_ServiceDesc is the grpc.ServiceDesc for Dekart service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -627,6 +1149,10 @@ var Dekart_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetDiscoverable",
 			Handler:    _Dekart_SetDiscoverable_Handler,
 		},
+		{
+			MethodName: "SetLinkSharing",
+			Handler:    _Dekart_SetLinkSharing_Handler,
+		},
 		{
 			MethodName: "CreateDataset",
 			Handler:    _Dekart_CreateDataset_Handler,
@@ -651,6 +1177,10 @@ var Dekart_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelQuery",
 			Handler:    _Dekart_CancelQuery_Handler,
 		},
+		{
+			MethodName: "EstimateQuery",
+			Handler:    _Dekart_EstimateQuery_Handler,
+		},
 		{
 			MethodName: "GetEnv",
 			Handler:    _Dekart_GetEnv_Handler,
@@ -659,6 +1189,62 @@ var Dekart_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUsage",
 			Handler:    _Dekart_GetUsage_Handler,
 		},
+		{
+			MethodName: "GetQuota",
+			Handler:    _Dekart_GetQuota_Handler,
+		},
+		{
+			MethodName: "GetSchedules",
+			Handler:    _Dekart_GetSchedules_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _Dekart_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _Dekart_UpdateSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _Dekart_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "GetReportRevisions",
+			Handler:    _Dekart_GetReportRevisions_Handler,
+		},
+		{
+			MethodName: "DiffReportRevisions",
+			Handler:    _Dekart_DiffReportRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreReportRevision",
+			Handler:    _Dekart_RestoreReportRevision_Handler,
+		},
+		{
+			MethodName: "GetReportPermissions",
+			Handler:    _Dekart_GetReportPermissions_Handler,
+		},
+		{
+			MethodName: "SetReportPermission",
+			Handler:    _Dekart_SetReportPermission_Handler,
+		},
+		{
+			MethodName: "SetReportParameters",
+			Handler:    _Dekart_SetReportParameters_Handler,
+		},
+		{
+			MethodName: "CreateApiToken",
+			Handler:    _Dekart_CreateApiToken_Handler,
+		},
+		{
+			MethodName: "GetApiTokens",
+			Handler:    _Dekart_GetApiTokens_Handler,
+		},
+		{
+			MethodName: "RevokeApiToken",
+			Handler:    _Dekart_RevokeApiToken_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3,6 +3,7 @@ package app
 import (
 	"dekart/src/proto"
 	"dekart/src/server/dekart"
+	"dekart/src/server/metrics"
 	"dekart/src/server/user"
 	"net/http"
 	"os"
@@ -11,6 +12,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
@@ -43,7 +45,10 @@ func (m ResponseWriter) WriteHeader(statusCode int) {
 var allowedOrigin string = os.Getenv("DEKART_CORS_ORIGIN")
 
 func configureGRPC(dekartServer *dekart.Server) *grpcweb.WrappedGrpcServer {
-	server := grpc.NewServer()
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor(), metrics.UnaryServerInterceptor),
+		grpc.ChainStreamInterceptor(otelgrpc.StreamServerInterceptor(), metrics.StreamServerInterceptor),
+	)
 	proto.RegisterDekartServer(server, dekartServer)
 	return grpcweb.WrapServer(
 		server,
@@ -83,6 +88,14 @@ func configureHTTP(dekartServer *dekart.Server) *mux.Router {
 		dekartServer.ServeDatasetSource(w, r)
 	}).Methods("GET", "OPTIONS")
 
+	api.HandleFunc("/query-result/{id}.parquet", func(w http.ResponseWriter, r *http.Request) {
+		setOriginHeader(w, r)
+		if r.Method == http.MethodOptions {
+			return
+		}
+		dekartServer.ServeQueryResultParquet(w, r)
+	}).Methods("GET", "OPTIONS")
+
 	api.HandleFunc("/query-source/{id}.sql", func(w http.ResponseWriter, r *http.Request) {
 		setOriginHeader(w, r)
 		if r.Method == http.MethodOptions {
@@ -99,6 +112,35 @@ func configureHTTP(dekartServer *dekart.Server) *mux.Router {
 		dekartServer.UploadFile(w, r)
 	}).Methods("POST", "OPTIONS")
 
+	// resumable upload: start, upload numbered chunks, complete
+	api.HandleFunc("/file/{id}/upload", func(w http.ResponseWriter, r *http.Request) {
+		setOriginHeader(w, r)
+		if r.Method == http.MethodOptions {
+			return
+		}
+		dekartServer.StartFileUpload(w, r)
+	}).Methods("POST", "OPTIONS")
+
+	api.HandleFunc("/file/{id}/upload/{chunk:[0-9]+}", func(w http.ResponseWriter, r *http.Request) {
+		setOriginHeader(w, r)
+		if r.Method == http.MethodOptions {
+			return
+		}
+		dekartServer.UploadFileChunk(w, r)
+	}).Methods("PUT", "OPTIONS")
+
+	api.HandleFunc("/file/{id}/upload/complete", func(w http.ResponseWriter, r *http.Request) {
+		setOriginHeader(w, r)
+		if r.Method == http.MethodOptions {
+			return
+		}
+		dekartServer.CompleteFileUpload(w, r)
+	}).Methods("POST", "OPTIONS")
+
+	router.Handle("/metrics", metrics.Handler()).Methods("GET")
+	router.HandleFunc("/healthz", dekartServer.ServeHealth).Methods("GET")
+	router.HandleFunc("/readyz", dekartServer.ServeReadiness).Methods("GET")
+
 	staticPath := os.Getenv("DEKART_STATIC_FILES")
 
 	if staticPath != "" {
@@ -129,7 +171,17 @@ func Configure(dekartServer *dekart.Server) *http.Server {
 		os.Getenv("DEKART_REQUIRE_AMAZON_OIDC") == "1",
 		os.Getenv("AWS_REGION"),
 		os.Getenv("DEKART_DEV_CLAIMS_EMAIL"),
-	)
+	).WithTokenResolver(dekartServer)
+	if os.Getenv("DEKART_REQUIRE_OIDC") == "1" {
+		claimsCheck = claimsCheck.WithOIDC(user.OIDCConfig{
+			Issuer:     os.Getenv("DEKART_OIDC_ISSUER"),
+			JWKSURL:    os.Getenv("DEKART_OIDC_JWKS_URL"),
+			KeyFile:    os.Getenv("DEKART_OIDC_KEY_FILE"),
+			Audience:   os.Getenv("DEKART_OIDC_AUDIENCE"),
+			Header:     os.Getenv("DEKART_OIDC_HEADER"),
+			EmailClaim: os.Getenv("DEKART_OIDC_EMAIL_CLAIM"),
+		})
+	}
 
 	port := os.Getenv("DEKART_PORT")
 	log.Info().Msgf("Starting dekart at :%s", port)
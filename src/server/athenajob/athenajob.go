@@ -5,7 +5,7 @@ import (
 	"dekart/src/server/job"
 	"dekart/src/server/storage"
 	"fmt"
-	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,13 +19,14 @@ type Store struct {
 	job.BasicStore
 	session        *session.Session
 	outputLocation string
+	config         job.Config
 }
 
-func NewStore(storage storage.Storage) *Store {
+func NewStore(storage storage.Storage, config job.Config) *Store {
 	conf := aws.NewConfig().
 		WithMaxRetries(3).
 		WithS3ForcePathStyle(true)
-	outputLocation := os.Getenv("DEKART_ATHENA_S3_OUTPUT_LOCATION")
+	outputLocation := config.Getenv("DEKART_ATHENA_S3_OUTPUT_LOCATION")
 	if outputLocation == "" {
 		log.Fatal().Msgf("athena data source require DEKART_ATHENA_S3_OUTPUT_LOCATION")
 	}
@@ -34,6 +35,7 @@ func NewStore(storage storage.Storage) *Store {
 	store := &Store{
 		session:        session,
 		outputLocation: fmt.Sprintf("s3://%s", outputLocation),
+		config:         config,
 	}
 	return store
 
@@ -41,6 +43,22 @@ func NewStore(storage storage.Storage) *Store {
 
 // Create a new Athena job within the store
 func (s *Store) Create(reportID string, queryID string, queryText string) (job.Job, chan int32, error) {
+	job := s.newJob(reportID, queryID, queryText)
+	s.StoreJob(job)
+	go s.RemoveJobWhenDone(job)
+	return job, job.Status(), nil
+}
+
+// Resume Athena job by queryExecutionId
+func (s *Store) Resume(reportID string, queryID string, queryText string, externalID string) (job.Job, chan int32, error) {
+	job := s.newJob(reportID, queryID, queryText)
+	job.resumeQueryExecutionId = externalID
+	s.StoreJob(job)
+	go s.RemoveJobWhenDone(job)
+	return job, job.Status(), nil
+}
+
+func (s *Store) newJob(reportID string, queryID string, queryText string) *Job {
 	client := athena.New(s.session)
 	job := &Job{
 		BasicJob: job.BasicJob{
@@ -52,11 +70,10 @@ func (s *Store) Create(reportID string, queryID string, queryText string) (job.J
 		session:        s.session,
 		client:         client,
 		outputLocation: s.outputLocation,
+		config:         s.config,
 	}
 	job.Init()
-	s.StoreJob(job)
-	go s.RemoveJobWhenDone(job)
-	return job, job.Status(), nil
+	return job
 }
 
 // Job implements dekart.Job interface for Athena
@@ -67,6 +84,9 @@ type Job struct {
 	client           *athena.Athena
 	outputLocation   string
 	storageObject    storage.StorageObject
+	config           job.Config
+
+	resumeQueryExecutionId string
 }
 
 func (j *Job) pullQueryExecutionStatus() (*athena.QueryExecution, error) {
@@ -91,7 +111,7 @@ func (j *Job) pullQueryExecutionStatus() (*athena.QueryExecution, error) {
 			status := *out.QueryExecution.Status.State
 			j.Logger.Debug().Str("status", status).Send()
 			switch status {
-			case "RUNNING":
+			case "QUEUED", "RUNNING":
 				continue
 			case "SUCCEEDED":
 				return out.QueryExecution, nil
@@ -108,6 +128,32 @@ func (j *Job) pullQueryExecutionStatus() (*athena.QueryExecution, error) {
 
 }
 
+// readSchema from result set metadata of query execution
+func (j *Job) readSchema() error {
+	out, err := j.client.GetQueryResultsWithContext(j.GetCtx(), &athena.GetQueryResultsInput{
+		QueryExecutionId: aws.String(j.queryExecutionId),
+		MaxResults:       aws.Int64(1),
+	})
+	if err != nil {
+		return err
+	}
+	if out.ResultSet == nil || out.ResultSet.ResultSetMetadata == nil {
+		return nil
+	}
+	columns := out.ResultSet.ResultSetMetadata.ColumnInfo
+	schema := make([]job.Column, len(columns))
+	for i, c := range columns {
+		schema[i] = job.Column{
+			Name:     aws.StringValue(c.Name),
+			Type:     aws.StringValue(c.Type),
+			Nullable: aws.StringValue(c.Nullable) != athena.ColumnNullableNotNull,
+			Geometry: strings.EqualFold(aws.StringValue(c.Type), "geometry"),
+		}
+	}
+	j.SetSchema(schema)
+	return nil
+}
+
 func (j *Job) wait() {
 	queryExecution, err := j.pullQueryExecutionStatus()
 	if err != nil {
@@ -120,6 +166,10 @@ func (j *Job) wait() {
 		j.ProcessedBytes = *queryExecution.Statistics.DataScannedInBytes
 		j.Unlock()
 	}
+	if err := j.readSchema(); err != nil {
+		// schema is informational, result is still usable
+		j.Logger.Warn().Err(err).Msg("Cannot read result schema")
+	}
 	j.Status() <- int32(proto.Query_JOB_STATUS_READING_RESULTS)
 	err = j.storageObject.CopyFromS3(j.GetCtx(), *queryExecution.ResultConfiguration.OutputLocation)
 	if err != nil {
@@ -147,15 +197,34 @@ func (j *Job) Run(storageObject storage.StorageObject) error {
 	j.storageObject = storageObject
 	j.Unlock()
 
+	if j.resumeQueryExecutionId != "" {
+		j.Lock()
+		j.queryExecutionId = j.resumeQueryExecutionId
+		j.ExternalID = j.resumeQueryExecutionId
+		j.Unlock()
+		j.Status() <- int32(proto.Query_JOB_STATUS_RUNNING)
+		j.Logger.Debug().Str("queryExecutionId", j.queryExecutionId).Msg("resumed, waiting")
+		go j.wait()
+		return nil
+	}
+
 	var athenaWorkgroup *string
-	if os.Getenv("DEKART_ATHENA_WORKGROUP") == "" {
+	if j.config.Getenv("DEKART_ATHENA_WORKGROUP") == "" {
 		j.Logger.Warn().Msg("athena workgroup not set or empty, this will default to the primary workgroup")
 	} else {
-		athenaWorkgroup = aws.String(os.Getenv("DEKART_ATHENA_WORKGROUP"))
+		athenaWorkgroup = aws.String(j.config.Getenv("DEKART_ATHENA_WORKGROUP"))
 		j.Logger.Debug().Msgf("athena workgroup set to %s", *athenaWorkgroup)
 	}
 
-	queryString := j.GetQueryText()
+	// aws-sdk-go version in use has no execution parameters, values are inlined as escaped literals
+	queryString, _, err := job.BindParameters(j.GetQueryText(), j.GetParameters(), func(n int, p job.Parameter) string {
+		return p.Literal()
+	})
+	if err != nil {
+		j.Logger.Warn().Err(err).Msg("Cannot bind query parameters")
+		j.CancelWithError(err)
+		return nil
+	}
 	out, err := j.client.StartQueryExecutionWithContext(j.GetCtx(), &athena.StartQueryExecutionInput{
 		QueryString: &queryString,
 		ResultConfiguration: &athena.ResultConfiguration{
@@ -171,6 +240,7 @@ func (j *Job) Run(storageObject storage.StorageObject) error {
 
 	j.Lock()
 	j.queryExecutionId = *out.QueryExecutionId
+	j.ExternalID = *out.QueryExecutionId
 	j.Unlock()
 
 	j.Status() <- int32(proto.Query_JOB_STATUS_RUNNING)
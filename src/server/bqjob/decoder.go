@@ -1,41 +1,390 @@
 package bqjob
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"dekart/src/server/job"
 
 	goavro "github.com/linkedin/goavro/v2"
 	bqStoragePb "google.golang.org/genproto/googleapis/cloud/bigquery/storage/v1"
 )
 
+// Decoder decodes Avro rows of BigQuery read session; fields of nested records are flattened
+// into dotted columns, repeated fields and records inside them are encoded as JSON
 type Decoder struct {
 	tableFields []string
+	schema      []job.Column
+	fields      []*avroNode
 	codec       *goavro.Codec
 }
 
+// avroType is Avro type definition with annotations added by BigQuery
+type avroType struct {
+	Type        json.RawMessage `json:"type"`
+	LogicalType string          `json:"logicalType"`
+	SQLType     string          `json:"sqlType"`
+	Items       json.RawMessage `json:"items"`
+	Fields      []AvroField     `json:"fields"`
+	Precision   int             `json:"precision"`
+	Scale       int             `json:"scale"`
+}
+
+// parseAvroType returns name of the type, its definition and whether type is union with null
+func parseAvroType(raw json.RawMessage) (string, avroType, bool, error) {
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		return name, avroType{}, false, nil
+	}
+	var union []json.RawMessage
+	if err := json.Unmarshal(raw, &union); err == nil {
+		var nonNull json.RawMessage
+		nullable := false
+		for _, member := range union {
+			if string(member) == `"null"` {
+				nullable = true
+			} else if nonNull == nil {
+				nonNull = member
+			}
+		}
+		if nonNull == nil {
+			return "null", avroType{}, true, nil
+		}
+		name, t, _, err := parseAvroType(nonNull)
+		return name, t, nullable, err
+	}
+	var t avroType
+	if err := json.Unmarshal(raw, &t); err != nil {
+		return "", t, false, fmt.Errorf("invalid avro type %s", raw)
+	}
+	name, _, nullable, err := parseAvroType(t.Type)
+	return name, t, nullable, err
+}
+
+// avroNode is compiled field of read session schema
+type avroNode struct {
+	name     string
+	kind     string // Avro type name
+	t        avroType
+	nullable bool
+	fields   []*avroNode // fields of record
+	items    *avroNode   // items of array
+	column   int         // index of column in row, -1 for flattened record
+}
+
+func compileAvroNode(name string, raw json.RawMessage) (*avroNode, error) {
+	kind, t, nullable, err := parseAvroType(raw)
+	if err != nil {
+		return nil, fmt.Errorf("field %s: %w", name, err)
+	}
+	node := &avroNode{
+		name:     name,
+		kind:     kind,
+		t:        t,
+		nullable: nullable,
+		column:   -1,
+	}
+	switch kind {
+	case "record":
+		for _, field := range t.Fields {
+			child, err := compileAvroNode(field.Name, field.Type)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", name, err)
+			}
+			node.fields = append(node.fields, child)
+		}
+	case "array":
+		node.items, err = compileAvroNode(name, t.Items)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+// bigQueryType restores BigQuery type from Avro type of read session schema
+func (n *avroNode) bigQueryType() string {
+	switch n.kind {
+	case "boolean":
+		return "BOOLEAN"
+	case "long":
+		switch n.t.LogicalType {
+		case "timestamp-micros":
+			return "TIMESTAMP"
+		case "time-micros":
+			return "TIME"
+		}
+		return "INTEGER"
+	case "int":
+		if n.t.LogicalType == "date" {
+			return "DATE"
+		}
+		return "INTEGER"
+	case "double":
+		return "FLOAT"
+	case "bytes":
+		if n.t.LogicalType == "decimal" {
+			if n.t.Precision > 38 {
+				return "BIGNUMERIC"
+			}
+			return "NUMERIC"
+		}
+		return "BYTES"
+	case "string":
+		if n.t.SQLType != "" {
+			return n.t.SQLType
+		}
+		if n.t.LogicalType == "datetime" {
+			return "DATETIME"
+		}
+		return "STRING"
+	case "record":
+		return "RECORD"
+	case "array":
+		return "ARRAY<" + n.items.bigQueryType() + ">"
+	}
+	return strings.ToUpper(n.kind)
+}
+
+// flatten assigns columns to fields, fields of records which are not repeated become dotted columns
+func flatten(fields []*avroNode, prefix string, nullable bool, schema []job.Column) []job.Column {
+	for _, field := range fields {
+		if field.kind == "record" {
+			schema = flatten(field.fields, prefix+field.name+".", nullable || field.nullable, schema)
+			continue
+		}
+		field.column = len(schema)
+		columnType := field.bigQueryType()
+		column := job.Column{
+			Name:     prefix + field.name,
+			Type:     columnType,
+			Nullable: nullable || field.nullable,
+			Geometry: columnType == "GEOGRAPHY",
+		}
+		switch columnType {
+		case "INTEGER":
+			column.ResultType = "int64"
+		case "FLOAT":
+			column.ResultType = "float64"
+		case "BOOLEAN":
+			column.ResultType = "bool"
+		}
+		schema = append(schema, column)
+	}
+	return schema
+}
+
+// schemaFromAvro compiles fields of read session Avro schema and maps them to result columns
+func schemaFromAvro(avroSchemaFields AvroSchema) ([]*avroNode, []job.Column, error) {
+	fields := make([]*avroNode, len(avroSchemaFields.Fields))
+	for i, field := range avroSchemaFields.Fields {
+		node, err := compileAvroNode(field.Name, field.Type)
+		if err != nil {
+			return nil, nil, err
+		}
+		fields[i] = node
+	}
+	return fields, flatten(fields, "", false, nil), nil
+}
+
 func NewDecoder(session *bqStoragePb.ReadSession) (*Decoder, error) {
 	avroSchema := session.GetAvroSchema()
+	return newDecoder(avroSchema.GetSchema())
+}
+
+func newDecoder(avroSchema string) (*Decoder, error) {
 	var avroSchemaFields AvroSchema
-	err := json.Unmarshal([]byte(avroSchema.GetSchema()), &avroSchemaFields)
+	err := json.Unmarshal([]byte(avroSchema), &avroSchemaFields)
 	if err != nil {
 		return nil, err
 	}
-	tableFields := make([]string, len(avroSchemaFields.Fields))
-	for i := range avroSchemaFields.Fields {
-		tableFields[i] = avroSchemaFields.Fields[i].Name
+	fields, schema, err := schemaFromAvro(avroSchemaFields)
+	if err != nil {
+		return nil, err
+	}
+	tableFields := make([]string, len(schema))
+	for i := range schema {
+		tableFields[i] = schema[i].Name
 	}
 
-	codec, err := goavro.NewCodec(avroSchema.GetSchema())
+	codec, err := goavro.NewCodec(avroSchema)
 	if err != nil {
 		return nil, err
 	}
 	return &Decoder{
 		tableFields: tableFields,
+		schema:      schema,
+		fields:      fields,
 		codec:       codec,
 	}, nil
 }
 
+// unwrap value of union with null, goavro decodes it as map with single key, the name of the type
+func (n *avroNode) unwrap(value interface{}) (interface{}, error) {
+	if !n.nullable || value == nil {
+		return value, nil
+	}
+	union, ok := value.(map[string]interface{})
+	if !ok || len(union) != 1 {
+		return nil, fmt.Errorf("field %s: unexpected union value %T", n.name, value)
+	}
+	for _, v := range union {
+		return v, nil
+	}
+	return nil, nil
+}
+
+// decodeRecord writes values of record fields to their columns of row
+func decodeRecord(fields []*avroNode, record map[string]interface{}, row []string) error {
+	for _, field := range fields {
+		value, err := field.unwrap(record[field.name])
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			continue
+		}
+		if field.column < 0 {
+			nested, ok := value.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("field %s: expected record, got %T", field.name, value)
+			}
+			if err := decodeRecord(field.fields, nested, row); err != nil {
+				return err
+			}
+			continue
+		}
+		if field.kind == "record" || field.kind == "array" {
+			var buf bytes.Buffer
+			if err := field.writeJSON(&buf, value); err != nil {
+				return err
+			}
+			row[field.column] = buf.String()
+			continue
+		}
+		row[field.column], err = field.format(value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// format scalar value as BigQuery does in CSV export, except timestamps which are RFC 3339
+func (n *avroNode) format(value interface{}) (string, error) {
+	switch x := value.(type) {
+	case string:
+		return x, nil
+	case bool:
+		return strconv.FormatBool(x), nil
+	case int64:
+		return strconv.FormatInt(x, 10), nil
+	case int32:
+		return strconv.FormatInt(int64(x), 10), nil
+	case float64:
+		return strconv.FormatFloat(x, 'g', -1, 64), nil
+	case []byte:
+		return base64.StdEncoding.EncodeToString(x), nil
+	case *big.Rat:
+		return formatDecimal(x, n.t.Scale), nil
+	case time.Time:
+		if n.t.LogicalType == "date" {
+			return x.Format("2006-01-02"), nil
+		}
+		return x.UTC().Format(time.RFC3339Nano), nil
+	case time.Duration:
+		return time.Time{}.Add(x).Format("15:04:05.999999"), nil
+	}
+	return "", fmt.Errorf("field %s: unexpected value %T", n.name, value)
+}
+
+// formatDecimal without trailing zeros
+func formatDecimal(r *big.Rat, scale int) string {
+	s := r.FloatString(scale)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
+
+// writeJSON encodes value keeping order of record fields; numbers which JSON cannot represent are strings
+func (n *avroNode) writeJSON(buf *bytes.Buffer, value interface{}) error {
+	value, err := n.unwrap(value)
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	switch n.kind {
+	case "record":
+		record, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("field %s: expected record, got %T", n.name, value)
+		}
+		buf.WriteByte('{')
+		for i, field := range n.fields {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			key, _ := json.Marshal(field.name)
+			buf.Write(key)
+			buf.WriteByte(':')
+			if err := field.writeJSON(buf, record[field.name]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("field %s: expected array, got %T", n.name, value)
+		}
+		buf.WriteByte('[')
+		for i, item := range items {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := n.items.writeJSON(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	}
+	s, err := n.format(value)
+	if err != nil {
+		return err
+	}
+	switch x := value.(type) {
+	case bool, int64, int32:
+		buf.WriteString(s)
+		return nil
+	case float64:
+		if !math.IsInf(x, 0) && !math.IsNaN(x) {
+			buf.WriteString(s)
+			return nil
+		}
+	}
+	if n.t.SQLType == "JSON" {
+		buf.WriteString(s)
+		return nil
+	}
+	quoted, _ := json.Marshal(s)
+	buf.Write(quoted)
+	return nil
+}
+
 func (d *Decoder) DecodeRows(undecoded []byte, csvRows chan []string) error {
 	var err error
 	for len(undecoded) > 0 {
@@ -52,30 +401,9 @@ func (d *Decoder) DecodeRows(undecoded []byte, csvRows chan []string) error {
 			err = fmt.Errorf("cannot convert datum to map")
 			return err
 		}
-		// TODO: create once?
 		csvRow := make([]string, len(d.tableFields))
-		for i, name := range d.tableFields {
-			value := valuesMap[name]
-			if value == nil {
-				csvRow[i] = ""
-				continue
-			}
-
-			switch x := value.(type) {
-			case map[string]interface{}:
-				for _, v := range x {
-					csvRow[i] = fmt.Sprintf("%v", v)
-					break
-				}
-			case []interface{}:
-				csvRow[i] = fmt.Sprintf("%v", x)
-			case interface{}:
-				csvRow[i] = fmt.Sprintf("%v", x)
-			case nil:
-				csvRow[i] = ""
-			default:
-				return fmt.Errorf("incorrect type of data: %T", x)
-			}
+		if err := decodeRecord(d.fields, valuesMap, csvRow); err != nil {
+			return err
 		}
 		csvRows <- csvRow
 	}
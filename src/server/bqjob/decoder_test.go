@@ -0,0 +1,177 @@
+package bqjob
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"dekart/src/server/job"
+
+	goavro "github.com/linkedin/goavro/v2"
+	"gotest.tools/v3/assert"
+)
+
+func TestDecoder(t *testing.T) {
+	timestamp := time.Date(2021, 3, 4, 5, 6, 7, 123456000, time.UTC)
+	tests := []struct {
+		name    string
+		fields  string
+		records []map[string]interface{}
+		schema  []job.Column
+		rows    [][]string
+	}{
+		{
+			name: "primitive types",
+			fields: `[
+				{"name": "id", "type": "long"},
+				{"name": "score", "type": ["null", "double"]},
+				{"name": "active", "type": ["null", "boolean"]},
+				{"name": "label", "type": ["null", "string"]},
+				{"name": "raw", "type": ["null", "bytes"]}
+			]`,
+			records: []map[string]interface{}{
+				{"id": int64(1), "score": goavro.Union("double", 1.5), "active": goavro.Union("boolean", true), "label": goavro.Union("string", "a"), "raw": goavro.Union("bytes", []byte("hi"))},
+				{"id": int64(-2), "score": nil, "active": nil, "label": nil, "raw": nil},
+			},
+			schema: []job.Column{
+				{Name: "id", Type: "INTEGER", ResultType: "int64"},
+				{Name: "score", Type: "FLOAT", Nullable: true, ResultType: "float64"},
+				{Name: "active", Type: "BOOLEAN", Nullable: true, ResultType: "bool"},
+				{Name: "label", Type: "STRING", Nullable: true},
+				{Name: "raw", Type: "BYTES", Nullable: true},
+			},
+			rows: [][]string{
+				{"1", "1.5", "true", "a", "aGk="},
+				{"-2", "", "", "", ""},
+			},
+		},
+		{
+			name: "logical types",
+			fields: `[
+				{"name": "at", "type": ["null", {"type": "long", "logicalType": "timestamp-micros"}]},
+				{"name": "day", "type": ["null", {"type": "int", "logicalType": "date"}]},
+				{"name": "clock", "type": ["null", {"type": "long", "logicalType": "time-micros"}]},
+				{"name": "amount", "type": ["null", {"type": "bytes", "logicalType": "decimal", "precision": 38, "scale": 9}]},
+				{"name": "big", "type": ["null", {"type": "bytes", "logicalType": "decimal", "precision": 77, "scale": 38}]},
+				{"name": "local", "type": ["null", {"type": "string", "sqlType": "DATETIME"}]}
+			]`,
+			records: []map[string]interface{}{
+				{
+					"at":     goavro.Union("long.timestamp-micros", timestamp),
+					"day":    goavro.Union("int.date", time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)),
+					"clock":  goavro.Union("long.time-micros", 13*time.Hour+30*time.Minute+time.Millisecond),
+					"amount": goavro.Union("bytes.decimal", big.NewRat(-1234567, 1000)),
+					"big":    goavro.Union("bytes.decimal", big.NewRat(1, 3)),
+					"local":  goavro.Union("string", "2021-03-04T05:06:07"),
+				},
+			},
+			schema: []job.Column{
+				{Name: "at", Type: "TIMESTAMP", Nullable: true},
+				{Name: "day", Type: "DATE", Nullable: true},
+				{Name: "clock", Type: "TIME", Nullable: true},
+				{Name: "amount", Type: "NUMERIC", Nullable: true},
+				{Name: "big", Type: "BIGNUMERIC", Nullable: true},
+				{Name: "local", Type: "DATETIME", Nullable: true},
+			},
+			rows: [][]string{
+				{"2021-03-04T05:06:07.123456Z", "2021-03-04", "13:30:00.001", "-1234.567", "0.33333333333333333333333333333333333333", "2021-03-04T05:06:07"},
+			},
+		},
+		{
+			name: "geography",
+			fields: `[
+				{"name": "area", "type": ["null", {"type": "string", "sqlType": "GEOGRAPHY"}]}
+			]`,
+			records: []map[string]interface{}{
+				{"area": goavro.Union("string", "POINT(1 2)")},
+			},
+			schema: []job.Column{
+				{Name: "area", Type: "GEOGRAPHY", Nullable: true, Geometry: true},
+			},
+			rows: [][]string{
+				{"POINT(1 2)"},
+			},
+		},
+		{
+			name: "nested record",
+			fields: `[
+				{"name": "id", "type": "long"},
+				{"name": "address", "type": ["null", {"type": "record", "name": "address", "fields": [
+					{"name": "city", "type": ["null", "string"]},
+					{"name": "location", "type": {"type": "record", "name": "location", "fields": [
+						{"name": "lat", "type": "double"},
+						{"name": "lon", "type": "double"}
+					]}}
+				]}]}
+			]`,
+			records: []map[string]interface{}{
+				{"id": int64(1), "address": goavro.Union("address", map[string]interface{}{
+					"city":     goavro.Union("string", "Berlin"),
+					"location": map[string]interface{}{"lat": 52.5, "lon": 13.4},
+				})},
+				{"id": int64(2), "address": nil},
+			},
+			schema: []job.Column{
+				{Name: "id", Type: "INTEGER", ResultType: "int64"},
+				{Name: "address.city", Type: "STRING", Nullable: true},
+				{Name: "address.location.lat", Type: "FLOAT", Nullable: true, ResultType: "float64"},
+				{Name: "address.location.lon", Type: "FLOAT", Nullable: true, ResultType: "float64"},
+			},
+			rows: [][]string{
+				{"1", "Berlin", "52.5", "13.4"},
+				{"2", "", "", ""},
+			},
+		},
+		{
+			name: "repeated fields",
+			fields: `[
+				{"name": "tags", "type": {"type": "array", "items": "string"}},
+				{"name": "stops", "type": {"type": "array", "items": {"type": "record", "name": "stop", "fields": [
+					{"name": "name", "type": ["null", "string"]},
+					{"name": "at", "type": ["null", {"type": "long", "logicalType": "timestamp-micros"}]},
+					{"name": "visits", "type": "long"}
+				]}}}
+			]`,
+			records: []map[string]interface{}{
+				{
+					"tags": []interface{}{"a", `b"c`},
+					"stops": []interface{}{
+						map[string]interface{}{"name": goavro.Union("string", "x"), "at": goavro.Union("long.timestamp-micros", timestamp), "visits": int64(3)},
+						map[string]interface{}{"name": nil, "at": nil, "visits": int64(0)},
+					},
+				},
+				{"tags": []interface{}{}, "stops": []interface{}{}},
+			},
+			schema: []job.Column{
+				{Name: "tags", Type: "ARRAY<STRING>"},
+				{Name: "stops", Type: "ARRAY<RECORD>"},
+			},
+			rows: [][]string{
+				{`["a","b\"c"]`, `[{"name":"x","at":"2021-03-04T05:06:07.123456Z","visits":3},{"name":null,"at":null,"visits":0}]`},
+				{`[]`, `[]`},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			avroSchema := `{"type": "record", "name": "__root__", "fields": ` + test.fields + `}`
+			decoder, err := newDecoder(avroSchema)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, decoder.schema, test.schema)
+
+			var undecoded []byte
+			for _, record := range test.records {
+				undecoded, err = decoder.codec.BinaryFromNative(undecoded, record)
+				assert.NilError(t, err)
+			}
+			csvRows := make(chan []string, len(test.records))
+			assert.NilError(t, decoder.DecodeRows(undecoded, csvRows))
+			close(csvRows)
+			var rows [][]string
+			for row := range csvRows {
+				rows = append(rows, row)
+			}
+			assert.DeepEqual(t, rows, test.rows)
+		})
+	}
+}
@@ -2,17 +2,18 @@ package bqjob
 
 import (
 	"context"
-	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"io"
-	"os"
 	"regexp"
+	"strings"
 
 	"dekart/src/proto"
 	"dekart/src/server/job"
 	"dekart/src/server/storage"
 
 	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
 	"google.golang.org/api/googleapi"
 )
 
@@ -24,14 +25,43 @@ type Job struct {
 	maxReadStreamsCount int32
 	maxBytesBilled      int64
 	client              *bigquery.Client
+	config              job.Config
+	resume              *resumeID
+}
+
+// resumeID identifies BigQuery job to re-attach to
+type resumeID struct {
+	projectID string
+	location  string
+	jobID     string
+}
+
+// externalID formats full BigQuery job id as project:location.id
+func externalID(bigqueryJob *bigquery.Job) string {
+	return fmt.Sprintf("%s:%s.%s", bigqueryJob.ProjectID(), bigqueryJob.Location(), bigqueryJob.ID())
+}
+
+func parseExternalID(externalID string) (projectID string, location string, jobID string, err error) {
+	parts := strings.SplitN(externalID, ":", 2)
+	if len(parts) != 2 {
+		return "", "", "", fmt.Errorf("invalid BigQuery job id %s", externalID)
+	}
+	projectID = parts[0]
+	parts = strings.SplitN(parts[1], ".", 2)
+	if len(parts) != 2 || projectID == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf("invalid BigQuery job id %s", externalID)
+	}
+	return projectID, parts[0], parts[1], nil
 }
 
 var contextCancelledRe = regexp.MustCompile(`context canceled`)
 var orderByRe = regexp.MustCompile(`(?ims)order[\s]+by`)
 
-func (job *Job) close(storageWriter io.WriteCloser, csvWriter *csv.Writer) {
-	csvWriter.Flush()
-	err := storageWriter.Close()
+func (job *Job) close(storageWriter io.WriteCloser, resultWriter job.ResultWriter) {
+	err := resultWriter.Flush()
+	if closeErr := storageWriter.Close(); err == nil {
+		err = closeErr
+	}
 	if err != nil {
 		// maybe we should not close when context is canceled in job.write()
 		if err == context.Canceled {
@@ -82,29 +112,63 @@ func (job *Job) setJobStats(queryStatus *bigquery.JobStatus, table *bigquery.Tab
 // write csv rows to storage
 func (job *Job) write(csvRows chan []string) {
 	storageWriter := job.storageObject.GetWriter(job.GetCtx())
-	csvWriter := csv.NewWriter(storageWriter)
+	resultWriter := job.NewResultWriter(storageWriter)
 	for {
 		csvRow, more := <-csvRows
 		if !more {
 			break
 		}
-		err := csvWriter.Write(csvRow)
+		err := resultWriter.Write(csvRow)
 		if err == context.Canceled {
 			break
 		}
+		if job.ResultTruncated(err) {
+			break
+		}
 		if err != nil {
 			job.Logger.Err(err).Send()
 			job.CancelWithError(err)
 			break
 		}
 	}
-	job.close(storageWriter, csvWriter)
+	job.close(storageWriter, resultWriter)
+}
+
+// bindParameters as named query parameters @name
+func bindParameters(queryText string, params []job.Parameter) (string, []bigquery.QueryParameter, error) {
+	queryText, bound, err := job.BindParameters(queryText, params, func(n int, p job.Parameter) string {
+		return "@" + p.Name
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	queryParams := make([]bigquery.QueryParameter, 0, len(bound))
+	seen := make(map[string]bool)
+	for _, p := range bound {
+		if seen[p.Name] {
+			continue
+		}
+		seen[p.Name] = true
+		value := p.Native()
+		if p.Type == proto.ReportParameter_TYPE_DATE {
+			value, err = civil.ParseDate(p.Value)
+			if err != nil {
+				return "", nil, err
+			}
+		}
+		queryParams = append(queryParams, bigquery.QueryParameter{Name: p.Name, Value: value})
+	}
+	return queryText, queryParams, nil
 }
 
 type AvroSchema struct {
-	Fields []struct {
-		Name string `json:"name"`
-	} `json:"fields"`
+	Fields []AvroField `json:"fields"`
+}
+
+// AvroField of record in Avro schema
+type AvroField struct {
+	Name string          `json:"name"`
+	Type json.RawMessage `json:"type"`
 }
 
 func (job *Job) processApiErrors(err error) {
@@ -112,7 +176,7 @@ func (job *Job) processApiErrors(err error) {
 		for _, e := range apiError.Errors {
 			if e.Reason == "bytesBilledLimitExceeded" {
 				job.Logger.Warn().Str(
-					"DEKART_BIGQUERY_MAX_BYTES_BILLED", os.Getenv("DEKART_BIGQUERY_MAX_BYTES_BILLED"),
+					"DEKART_BIGQUERY_MAX_BYTES_BILLED", job.config.Getenv("DEKART_BIGQUERY_MAX_BYTES_BILLED"),
 				).Msg(e.Message)
 			}
 		}
@@ -187,14 +251,15 @@ func (job *Job) wait() {
 	csvRows := make(chan []string, job.TotalRows)
 	errors := make(chan error)
 
-	// read table rows into csvRows
+	// read table rows into csvRows, reading stops when result is truncated
 	go Read(
-		job.GetCtx(),
+		job.GetReadCtx(),
 		errors,
 		csvRows,
 		table,
 		job.Logger,
 		job.maxReadStreamsCount,
+		job.SetSchema,
 	)
 
 	// write csvRows to storage
@@ -222,19 +287,34 @@ func (job *Job) setMaxReadStreamsCount(queryText string) {
 // Run implementation
 func (job *Job) Run(storageObject storage.StorageObject) error {
 	job.Logger.Debug().Msg("Run BigQuery Job")
-	client, err := bigquery.NewClient(job.GetCtx(), os.Getenv("DEKART_BIGQUERY_PROJECT_ID"))
+	projectID := job.config.Getenv("DEKART_BIGQUERY_PROJECT_ID")
+	if job.resume != nil {
+		projectID = job.resume.projectID
+	}
+	client, err := bigquery.NewClient(job.GetCtx(), projectID)
 	if err != nil {
 		job.Cancel()
 		return err
 	}
 	job.client = client
 
-	query := client.Query(job.QueryText)
-	query.MaxBytesBilled = job.maxBytesBilled
-
 	job.setMaxReadStreamsCount(job.QueryText)
 
-	bigqueryJob, err := query.Run(job.GetCtx())
+	var bigqueryJob *bigquery.Job
+	if job.resume != nil {
+		job.Logger.Debug().Str("jobID", job.resume.jobID).Msg("Resume BigQuery Job")
+		bigqueryJob, err = client.JobFromIDLocation(job.GetCtx(), job.resume.jobID, job.resume.location)
+	} else {
+		queryText, queryParams, bindErr := bindParameters(job.QueryText, job.GetParameters())
+		if bindErr != nil {
+			job.Cancel()
+			return bindErr
+		}
+		query := client.Query(queryText)
+		query.Parameters = queryParams
+		query.MaxBytesBilled = job.maxBytesBilled
+		bigqueryJob, err = query.Run(job.GetCtx())
+	}
 	if err != nil {
 		job.Cancel()
 		return err
@@ -242,6 +322,7 @@ func (job *Job) Run(storageObject storage.StorageObject) error {
 	job.Lock()
 	job.bigqueryJob = bigqueryJob
 	job.storageObject = storageObject
+	job.ExternalID = externalID(bigqueryJob)
 	job.Unlock()
 	job.Status() <- int32(proto.Query_JOB_STATUS_RUNNING)
 	job.Logger.Debug().Msg("Waiting for results")
@@ -6,10 +6,14 @@ import (
 	"io"
 	"sync"
 
+	"dekart/src/server/job"
+	"dekart/src/server/tracing"
+
 	"cloud.google.com/go/bigquery"
 	bqStorage "cloud.google.com/go/bigquery/storage/apiv1"
 	gax "github.com/googleapis/gax-go/v2"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
 	bqStoragePb "google.golang.org/genproto/googleapis/cloud/bigquery/storage/v1"
 	"google.golang.org/grpc"
 )
@@ -111,9 +115,13 @@ func (r *Reader) newStreamReader(streamName string, csvRows chan []string, error
 	return &streamReader
 }
 
-func Read(ctx context.Context, errors chan error, csvRows chan []string, table *bigquery.Table, logger zerolog.Logger, maxReadStreamsCount int32) {
+// Read table rows into csvRows, setSchema is called with result schema before header row is sent
+func Read(ctx context.Context, errors chan error, csvRows chan []string, table *bigquery.Table, logger zerolog.Logger, maxReadStreamsCount int32, setSchema func([]job.Column)) {
 	defer close(errors)
 	defer close(csvRows)
+	ctx, span := tracing.Start(ctx, "bigquery.Read", attribute.String("bigquery.table", table.FullyQualifiedName()))
+	var err error
+	defer func() { tracing.End(span, err) }()
 	r, err := NewReader(ctx, errors, csvRows, table, logger, maxReadStreamsCount)
 	if err != nil {
 		errors <- err
@@ -121,6 +129,7 @@ func Read(ctx context.Context, errors chan error, csvRows chan []string, table *
 	}
 	defer r.close()
 
+	setSchema(r.tableDecoder.schema)
 	csvRows <- r.getTableFields()
 	readStreams, err := r.getStreams()
 	if err != nil {
@@ -128,6 +137,7 @@ func Read(ctx context.Context, errors chan error, csvRows chan []string, table *
 		return
 	}
 
+	span.SetAttributes(attribute.Int("bigquery.streams", len(readStreams)))
 	var proccessWaitGroup sync.WaitGroup
 	for _, stream := range readStreams {
 		proccessWaitGroup.Add(1)
@@ -149,7 +159,14 @@ func (r *StreamReader) readStream() {
 	r.logger.Debug().Msg("Start Reading Stream")
 	defer close(r.resCh)
 	defer r.logger.Debug().Msg("Finish Reading Stream")
-	rowStream, err := r.bqReadClient.ReadRows(r.ctx, &bqStoragePb.ReadRowsRequest{
+	ctx, span := tracing.Start(r.ctx, "bigquery.ReadStream", attribute.String("bigquery.stream", r.streamName))
+	var rowCount int64
+	var err error
+	defer func() {
+		span.SetAttributes(attribute.Int64("bigquery.rows", rowCount))
+		tracing.End(span, err)
+	}()
+	rowStream, err := r.bqReadClient.ReadRows(ctx, &bqStoragePb.ReadRowsRequest{
 		ReadStream: r.streamName,
 	}, rpcOpts)
 	if err != nil {
@@ -158,10 +175,12 @@ func (r *StreamReader) readStream() {
 		return
 	}
 	for {
-		res, err := rowStream.Recv()
+		var res *bqStoragePb.ReadRowsResponse
+		res, err = rowStream.Recv()
 
 		if err != nil {
 			if err == io.EOF {
+				err = nil
 				break
 			}
 			if err == context.Canceled {
@@ -174,7 +193,13 @@ func (r *StreamReader) readStream() {
 			r.errors <- err
 			return
 		}
-		r.resCh <- res
+		rowCount += res.GetRowCount()
+		select {
+		case r.resCh <- res:
+		case <-r.ctx.Done():
+			// job is done or result is truncated, responses are not processed anymore
+			return
+		}
 	}
 }
 
@@ -1,34 +1,114 @@
 package bqjob
 
 import (
+	"context"
 	"dekart/src/server/job"
-	"os"
 	"strconv"
+	"sync"
 
+	"cloud.google.com/go/bigquery"
 	"github.com/rs/zerolog/log"
 )
 
 // Store implements job.Store interface for BigQuery
 type Store struct {
 	job.BasicStore
+	config job.Config
+
+	clientMu sync.Mutex
+	client   *bigquery.Client // shared by dry runs, created on first estimate
 }
 
 // NewStore instance
-func NewStore() *Store {
-	store := &Store{}
+func NewStore(config job.Config) *Store {
+	store := &Store{
+		config: config,
+	}
 	return store
 }
 
 // Create job on store
 func (s *Store) Create(reportID string, queryID string, queryText string) (job.Job, chan int32, error) {
-	maxBytesBilledStr := os.Getenv("DEKART_BIGQUERY_MAX_BYTES_BILLED")
+	job, err := s.newJob(reportID, queryID, queryText)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.StoreJob(job)
+	go s.RemoveJobWhenDone(job)
+	return job, job.Status(), nil
+}
+
+// Resume BigQuery job by its full id project:location.id
+func (s *Store) Resume(reportID string, queryID string, queryText string, externalID string) (job.Job, chan int32, error) {
+	projectID, location, jobID, err := parseExternalID(externalID)
+	if err != nil {
+		return nil, nil, err
+	}
+	job, err := s.newJob(reportID, queryID, queryText)
+	if err != nil {
+		return nil, nil, err
+	}
+	job.resume = &resumeID{
+		projectID: projectID,
+		location:  location,
+		jobID:     jobID,
+	}
+	s.StoreJob(job)
+	go s.RemoveJobWhenDone(job)
+	return job, job.Status(), nil
+}
+
+// Estimate bytes processed by query with BigQuery dry run
+func (s *Store) Estimate(ctx context.Context, queryText string, params []job.Parameter) (*job.Estimate, error) {
+	queryText, queryParams, err := bindParameters(queryText, params)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.getClient()
+	if err != nil {
+		return nil, err
+	}
+	query := client.Query(queryText)
+	query.Parameters = queryParams
+	query.DryRun = true
+	bigqueryJob, err := query.Run(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queryStatus := bigqueryJob.LastStatus()
+	if err := queryStatus.Err(); err != nil {
+		return nil, err
+	}
+	estimate := &job.Estimate{}
+	if queryStatus.Statistics != nil {
+		estimate.Bytes = queryStatus.Statistics.TotalBytesProcessed
+	}
+	return estimate, nil
+}
+
+// getClient returns client for dry runs; it is not bound to request context so it outlives single estimate
+func (s *Store) getClient() (*bigquery.Client, error) {
+	s.clientMu.Lock()
+	defer s.clientMu.Unlock()
+	if s.client == nil {
+		client, err := bigquery.NewClient(context.Background(), s.config.Getenv("DEKART_BIGQUERY_PROJECT_ID"))
+		if err != nil {
+			return nil, err
+		}
+		s.client = client
+	}
+	return s.client, nil
+}
+
+func (s *Store) newJob(reportID string, queryID string, queryText string) (*Job, error) {
+	maxBytesBilledStr := s.config.Getenv("DEKART_BIGQUERY_MAX_BYTES_BILLED")
 	var maxBytesBilled int64
 	var err error
 	if maxBytesBilledStr != "" {
 		maxBytesBilled, err = strconv.ParseInt(maxBytesBilledStr, 10, 64)
 		if err != nil {
 			log.Fatal().Msgf("Cannot parse DEKART_BIGQUERY_MAX_BYTES_BILLED")
-			return nil, nil, err
+			return nil, err
 		}
 	} else {
 		log.Warn().Msgf("DEKART_BIGQUERY_MAX_BYTES_BILLED is not set! Use the maximum bytes billed setting to limit query costs. https://cloud.google.com/bigquery/docs/best-practices-costs#limit_query_costs_by_restricting_the_number_of_bytes_billed")
@@ -41,9 +121,8 @@ func (s *Store) Create(reportID string, queryID string, queryText string) (job.J
 			Logger:    log.With().Str("reportID", reportID).Str("queryID", queryID).Logger(),
 		},
 		maxBytesBilled: maxBytesBilled,
+		config:         s.config,
 	}
 	job.Init()
-	s.StoreJob(job)
-	go s.RemoveJobWhenDone(job)
-	return job, job.Status(), nil
+	return job, nil
 }
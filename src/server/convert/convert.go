@@ -0,0 +1,16 @@
+// Package convert turns uploaded files into formats which map client can load
+package convert
+
+// Column of converted file schema
+type Column struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Nullable bool   `json:"nullable"`
+	Geometry bool   `json:"geometry"`
+}
+
+// Result of conversion
+type Result struct {
+	RowCount int64
+	Schema   []Column
+}
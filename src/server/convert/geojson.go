@@ -0,0 +1,77 @@
+package convert
+
+import (
+	"bufio"
+	"dekart/src/server/geom"
+	"encoding/json"
+	"io"
+)
+
+// featureWriter streams GeoJSON FeatureCollection and collects its schema
+type featureWriter struct {
+	w       *bufio.Writer
+	result  Result
+	columns map[string]bool
+}
+
+func newFeatureWriter(w io.Writer) (*featureWriter, error) {
+	fw := &featureWriter{
+		w:       bufio.NewWriter(w),
+		columns: make(map[string]bool),
+	}
+	fw.addColumn("geometry", "geometry")
+	_, err := fw.w.WriteString(`{"type":"FeatureCollection","features":[`)
+	return fw, err
+}
+
+// addColumn to schema unless it is already there; properties are nullable
+func (fw *featureWriter) addColumn(name string, columnType string) {
+	if fw.columns[name] {
+		return
+	}
+	fw.columns[name] = true
+	fw.result.Schema = append(fw.result.Schema, Column{
+		Name:     name,
+		Type:     columnType,
+		Nullable: true,
+		Geometry: columnType == "geometry",
+	})
+}
+
+type feature struct {
+	Type       string                 `json:"type"`
+	Geometry   *geom.Geometry         `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// write feature, geometry must be already reprojected to WGS84
+func (fw *featureWriter) write(g *geom.Geometry, properties map[string]interface{}) error {
+	if fw.result.RowCount > 0 {
+		if err := fw.w.WriteByte(','); err != nil {
+			return err
+		}
+	}
+	b, err := json.Marshal(feature{
+		Type:       "Feature",
+		Geometry:   g,
+		Properties: properties,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := fw.w.Write(b); err != nil {
+		return err
+	}
+	fw.result.RowCount++
+	return nil
+}
+
+func (fw *featureWriter) close() (*Result, error) {
+	if _, err := fw.w.WriteString("]}"); err != nil {
+		return nil, err
+	}
+	if err := fw.w.Flush(); err != nil {
+		return nil, err
+	}
+	return &fw.result, nil
+}
@@ -0,0 +1,222 @@
+package convert
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"dekart/src/server/geom"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testFeatureCollection struct {
+	Type     string `json:"type"`
+	Features []struct {
+		Geometry struct {
+			Type        string          `json:"type"`
+			Coordinates json.RawMessage `json:"coordinates"`
+		} `json:"geometry"`
+		Properties map[string]interface{} `json:"properties"`
+	} `json:"features"`
+}
+
+func parseFeatureCollection(t *testing.T, b []byte) testFeatureCollection {
+	var fc testFeatureCollection
+	require.NoError(t, json.Unmarshal(b, &fc), string(b))
+	require.Equal(t, "FeatureCollection", fc.Type)
+	return fc
+}
+
+func requireCoordinates(t *testing.T, expected []float64, raw json.RawMessage) {
+	var actual []float64
+	require.NoError(t, json.Unmarshal(raw, &actual))
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		require.InDelta(t, expected[i], actual[i], 1e-5)
+	}
+}
+
+func TestKMLToGeoJSON(t *testing.T) {
+	kml := `<?xml version="1.0" encoding="UTF-8"?>
+<kml xmlns="http://www.opengis.net/kml/2.2">
+<Document><Folder>
+	<Placemark>
+		<name>Office</name>
+		<ExtendedData><Data name="floor"><value>3</value></Data></ExtendedData>
+		<Point><coordinates>13.4,52.5,34</coordinates></Point>
+	</Placemark>
+	<Placemark>
+		<name>Park</name>
+		<MultiGeometry>
+			<Polygon><outerBoundaryIs><LinearRing><coordinates>0,0 1,0 1,1 0,0</coordinates></LinearRing></outerBoundaryIs></Polygon>
+			<Polygon><outerBoundaryIs><LinearRing><coordinates>2,2 3,2 3,3 2,2</coordinates></LinearRing></outerBoundaryIs></Polygon>
+		</MultiGeometry>
+	</Placemark>
+</Folder></Document>
+</kml>`
+	var out bytes.Buffer
+	result, err := KMLToGeoJSON(context.Background(), bytes.NewBufferString(kml), &out)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), result.RowCount)
+	require.Equal(t, []string{"geometry", "name", "description", "floor"}, columnNames(result.Schema))
+	fc := parseFeatureCollection(t, out.Bytes())
+	require.Equal(t, "Point", fc.Features[0].Geometry.Type)
+	requireCoordinates(t, []float64{13.4, 52.5, 34}, fc.Features[0].Geometry.Coordinates)
+	require.Equal(t, "3", fc.Features[0].Properties["floor"])
+	require.Equal(t, "MultiPolygon", fc.Features[1].Geometry.Type)
+
+	_, err = KMLToGeoJSON(context.Background(), bytes.NewBufferString(`<kml><Placemark><Point><coordinates>a,b</coordinates></Point></Placemark></kml>`), &out)
+	require.Error(t, err)
+}
+
+func columnNames(schema []Column) []string {
+	names := make([]string, len(schema))
+	for i, c := range schema {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// shapefileZip builds zipped shapefile with one point in UTM zone 32N
+func shapefileZip(t *testing.T) []byte {
+	var shp bytes.Buffer
+	header := make([]byte, 100)
+	binary.BigEndian.PutUint32(header, 9994)
+	binary.LittleEndian.PutUint32(header[32:], 1)
+	shp.Write(header)
+	record := make([]byte, 8+20)
+	binary.BigEndian.PutUint32(record, 1)
+	binary.BigEndian.PutUint32(record[4:], 10)
+	binary.LittleEndian.PutUint32(record[8:], 1)
+	binary.LittleEndian.PutUint64(record[12:], math.Float64bits(691875.63214))
+	binary.LittleEndian.PutUint64(record[20:], math.Float64bits(6098907.82501))
+	shp.Write(record)
+
+	var dbf bytes.Buffer
+	dbfHeader := make([]byte, 32)
+	dbfHeader[0] = 3
+	binary.LittleEndian.PutUint32(dbfHeader[4:], 1)
+	binary.LittleEndian.PutUint16(dbfHeader[8:], 32+32*2+1)
+	binary.LittleEndian.PutUint16(dbfHeader[10:], 1+10+5)
+	dbf.Write(dbfHeader)
+	field := make([]byte, 32)
+	copy(field, "NAME")
+	field[11] = 'C'
+	field[16] = 10
+	dbf.Write(field)
+	field = make([]byte, 32)
+	copy(field, "POP")
+	field[11] = 'N'
+	field[16] = 5
+	dbf.Write(field)
+	dbf.WriteByte(0x0D)
+	dbf.WriteString(" Gr\xf8nhus    1200")
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, content := range map[string][]byte{
+		"data/places.shp": shp.Bytes(),
+		"data/places.dbf": dbf.Bytes(),
+		"data/places.prj": []byte(utm32WKT),
+	} {
+		f, err := archive.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+	return buf.Bytes()
+}
+
+func TestShapefileToGeoJSON(t *testing.T) {
+	data := shapefileZip(t)
+	var out bytes.Buffer
+	result, err := ShapefileToGeoJSON(context.Background(), bytes.NewReader(data), int64(len(data)), &out)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), result.RowCount)
+	require.Equal(t, []Column{
+		{Name: "geometry", Type: "geometry", Nullable: true, Geometry: true},
+		{Name: "NAME", Type: "string", Nullable: true},
+		{Name: "POP", Type: "number", Nullable: true},
+	}, result.Schema)
+	fc := parseFeatureCollection(t, out.Bytes())
+	require.Equal(t, "Point", fc.Features[0].Geometry.Type)
+	requireCoordinates(t, []float64{12, 55}, fc.Features[0].Geometry.Coordinates)
+	require.Equal(t, "Grønhus", fc.Features[0].Properties["NAME"])
+	require.Equal(t, float64(1200), fc.Features[0].Properties["POP"])
+
+	_, err = ShapefileToGeoJSON(context.Background(), bytes.NewReader([]byte("not zip")), 7, &out)
+	require.Error(t, err)
+}
+
+func coords(values []float64) []geom.Coord {
+	res := make([]geom.Coord, len(values)/2)
+	for i := range res {
+		res[i] = geom.Coord{values[i*2], values[i*2+1]}
+	}
+	return res
+}
+
+func float64Bytes(v float64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, math.Float64bits(v))
+	return b
+}
+
+func TestPolygonFromRings(t *testing.T) {
+	outer := []float64{0, 0, 0, 10, 10, 10, 10, 0, 0, 0}
+	hole := []float64{2, 2, 4, 2, 4, 4, 2, 4, 2, 2}
+	second := []float64{20, 20, 20, 30, 30, 30, 20, 20}
+	g := polygonFromRings([][]geom.Coord{coords(outer), coords(hole)}, false)
+	require.Equal(t, "Polygon", g.Type)
+	require.Len(t, g.Rings, 2)
+	g = polygonFromRings([][]geom.Coord{coords(outer), coords(second), coords(hole)}, false)
+	require.Equal(t, "MultiPolygon", g.Type)
+	require.Len(t, g.Polygons[0], 2)
+	require.Len(t, g.Polygons[1], 1)
+}
+
+func TestGeoPackageToGeoJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.gpkg")
+	db, err := sql.Open("sqlite", path)
+	require.NoError(t, err)
+	for _, statement := range []string{
+		`create table gpkg_spatial_ref_sys (srs_name text, srs_id integer primary key, organization text, organization_coordsys_id integer, definition text)`,
+		`create table gpkg_contents (table_name text primary key, data_type text)`,
+		`create table gpkg_geometry_columns (table_name text, column_name text, geometry_type_name text, srs_id integer)`,
+		`insert into gpkg_spatial_ref_sys values ('UTM 32N', 32632, 'EPSG', 32632, 'undefined')`,
+		`insert into gpkg_contents values ('places', 'features')`,
+		`insert into gpkg_geometry_columns values ('places', 'geom', 'POINT', 32632)`,
+		`create table places (fid integer primary key, geom blob, name text, visitors integer)`,
+	} {
+		_, err = db.Exec(statement)
+		require.NoError(t, err)
+	}
+	// GeoPackage header without envelope followed by little endian WKB point
+	blob, err := hex.DecodeString("47500001787f0000" + "0101000000" + hex.EncodeToString(float64Bytes(691875.63214)) + hex.EncodeToString(float64Bytes(6098907.82501)))
+	require.NoError(t, err)
+	_, err = db.Exec(`insert into places values (1, ?, 'Harbour', 42)`, blob)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var out bytes.Buffer
+	result, err := GeoPackageToGeoJSON(context.Background(), f, &out)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), result.RowCount)
+	require.Equal(t, []string{"geometry", "fid", "name", "visitors"}, columnNames(result.Schema))
+	fc := parseFeatureCollection(t, out.Bytes())
+	requireCoordinates(t, []float64{12, 55}, fc.Features[0].Geometry.Coordinates)
+	require.Equal(t, "Harbour", fc.Features[0].Properties["name"])
+	require.Equal(t, float64(42), fc.Features[0].Properties["visitors"])
+}
@@ -0,0 +1,222 @@
+package convert
+
+import (
+	"context"
+	"database/sql"
+	"dekart/src/server/geom"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	// pure Go SQLite driver, GeoPackage is SQLite database
+	_ "modernc.org/sqlite"
+)
+
+type geoPackageLayer struct {
+	table          string
+	geometryColumn string
+	srsID          int
+}
+
+// GeoPackageToGeoJSON converts all feature tables of GeoPackage to one GeoJSON in WGS84;
+// features of multiple tables get layer property with table name
+func GeoPackageToGeoJSON(ctx context.Context, r io.Reader, w io.Writer) (*Result, error) {
+	// SQLite reads database from file, so upload is copied to temp file first
+	tmp, err := ioutil.TempFile("", "dekart-*.gpkg")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("sqlite", tmp.Name())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	layers, err := getGeoPackageLayers(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read GeoPackage: %w", err)
+	}
+	if len(layers) == 0 {
+		return nil, fmt.Errorf("no feature tables in GeoPackage")
+	}
+	fw, err := newFeatureWriter(w)
+	if err != nil {
+		return nil, err
+	}
+	if len(layers) > 1 {
+		fw.addColumn("layer", "string")
+	}
+	for _, layer := range layers {
+		projection, err := getGeoPackageProjection(ctx, db, layer.srsID)
+		if err != nil {
+			return nil, fmt.Errorf("table %s: %w", layer.table, err)
+		}
+		if err := convertGeoPackageLayer(ctx, db, layer, projection, len(layers) > 1, fw); err != nil {
+			return nil, fmt.Errorf("table %s: %w", layer.table, err)
+		}
+	}
+	return fw.close()
+}
+
+func getGeoPackageLayers(ctx context.Context, db *sql.DB) ([]geoPackageLayer, error) {
+	rows, err := db.QueryContext(ctx,
+		`select g.table_name, g.column_name, g.srs_id
+		from gpkg_contents c join gpkg_geometry_columns g on c.table_name = g.table_name
+		where c.data_type = 'features'
+		order by c.table_name`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var layers []geoPackageLayer
+	for rows.Next() {
+		var layer geoPackageLayer
+		if err := rows.Scan(&layer.table, &layer.geometryColumn, &layer.srsID); err != nil {
+			return nil, err
+		}
+		layers = append(layers, layer)
+	}
+	return layers, rows.Err()
+}
+
+// getGeoPackageProjection from definition of spatial reference system, EPSG code is used when definition is not supported
+func getGeoPackageProjection(ctx context.Context, db *sql.DB, srsID int) (Projection, error) {
+	// 0 and -1 are undefined geographic and cartesian systems
+	if srsID == 0 || srsID == -1 {
+		return WGS84, nil
+	}
+	var organization string
+	var code int
+	var definition string
+	err := db.QueryRowContext(ctx,
+		`select organization, organization_coordsys_id, definition from gpkg_spatial_ref_sys where srs_id = ?`,
+		srsID,
+	).Scan(&organization, &code, &definition)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("unknown srs_id %d", srsID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	projection, err := ParseProjection(definition)
+	if err != nil && strings.EqualFold(organization, "EPSG") {
+		if p, epsgErr := EPSGProjection(code); epsgErr == nil {
+			return p, nil
+		}
+	}
+	return projection, err
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func convertGeoPackageLayer(ctx context.Context, db *sql.DB, layer geoPackageLayer, projection Projection, addLayer bool, fw *featureWriter) error {
+	rows, err := db.QueryContext(ctx, "select * from "+quoteIdentifier(layer.table))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+	for _, c := range columnTypes {
+		if c.Name() != layer.geometryColumn {
+			fw.addColumn(c.Name(), strings.ToLower(c.DatabaseTypeName()))
+		}
+	}
+	values := make([]interface{}, len(columnTypes))
+	pointers := make([]interface{}, len(columnTypes))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return err
+		}
+		var g *geom.Geometry
+		properties := make(map[string]interface{}, len(columnTypes))
+		if addLayer {
+			properties["layer"] = layer.table
+		}
+		for i, c := range columnTypes {
+			if c.Name() == layer.geometryColumn {
+				blob, ok := values[i].([]byte)
+				if !ok || blob == nil {
+					continue
+				}
+				g, err = parseGeoPackageGeometry(blob)
+				if err != nil {
+					return fmt.Errorf("row %d: %w", fw.result.RowCount+1, err)
+				}
+				if g != nil {
+					if err := Reproject(g, projection); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if b, ok := values[i].([]byte); ok {
+				properties[c.Name()] = base64.StdEncoding.EncodeToString(b)
+				continue
+			}
+			properties[c.Name()] = values[i]
+		}
+		if err := fw.write(g, properties); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// envelope sizes by envelope contents indicator of GeoPackage binary header
+var geoPackageEnvelopeSizes = []int{0, 32, 48, 48, 64}
+
+// parseGeoPackageGeometry decodes GeoPackage binary: header with optional envelope followed by WKB;
+// empty geometry is returned as nil
+func parseGeoPackageGeometry(b []byte) (*geom.Geometry, error) {
+	if len(b) < 8 || b[0] != 'G' || b[1] != 'P' {
+		return nil, fmt.Errorf("invalid GeoPackage geometry")
+	}
+	flags := b[3]
+	if flags&0x20 != 0 {
+		return nil, fmt.Errorf("extended GeoPackage geometry types are not supported")
+	}
+	if flags&0x10 != 0 {
+		return nil, nil
+	}
+	envelope := int(flags>>1) & 0x07
+	if envelope >= len(geoPackageEnvelopeSizes) {
+		return nil, fmt.Errorf("invalid GeoPackage envelope indicator %d", envelope)
+	}
+	offset := 8 + geoPackageEnvelopeSizes[envelope]
+	if len(b) < offset {
+		return nil, fmt.Errorf("invalid GeoPackage geometry")
+	}
+	g, err := geom.ParseWKB(b[offset:])
+	if err != nil {
+		return nil, err
+	}
+	var srsID int32
+	if flags&0x01 != 0 {
+		srsID = int32(binary.LittleEndian.Uint32(b[4:8]))
+	} else {
+		srsID = int32(binary.BigEndian.Uint32(b[4:8]))
+	}
+	g.SRID = int(srsID)
+	return g, nil
+}
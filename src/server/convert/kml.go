@@ -0,0 +1,238 @@
+package convert
+
+import (
+	"archive/zip"
+	"context"
+	"dekart/src/server/geom"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+type kmlCoordinates struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+type kmlPolygon struct {
+	Outer kmlCoordinates   `xml:"outerBoundaryIs>LinearRing"`
+	Inner []kmlCoordinates `xml:"innerBoundaryIs>LinearRing"`
+}
+
+type kmlGeometries struct {
+	Points          []kmlCoordinates `xml:"Point"`
+	LineStrings     []kmlCoordinates `xml:"LineString"`
+	LinearRings     []kmlCoordinates `xml:"LinearRing"`
+	Polygons        []kmlPolygon     `xml:"Polygon"`
+	MultiGeometries []kmlGeometries  `xml:"MultiGeometry"`
+}
+
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+type kmlSimpleData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
+}
+
+type kmlPlacemark struct {
+	Name        string          `xml:"name"`
+	Description string          `xml:"description"`
+	Data        []kmlData       `xml:"ExtendedData>Data"`
+	SimpleData  []kmlSimpleData `xml:"ExtendedData>SchemaData>SimpleData"`
+	kmlGeometries
+}
+
+// parseKMLCoordinates parses "lon,lat[,alt] lon,lat[,alt]" tuples
+func parseKMLCoordinates(s string) ([]geom.Coord, bool, error) {
+	tuples := strings.Fields(s)
+	coords := make([]geom.Coord, 0, len(tuples))
+	hasZ := false
+	for _, tuple := range tuples {
+		parts := strings.Split(tuple, ",")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, false, fmt.Errorf("invalid KML coordinates %s", tuple)
+		}
+		coord := make(geom.Coord, len(parts))
+		for i, part := range parts {
+			v, err := strconv.ParseFloat(part, 64)
+			if err != nil {
+				return nil, false, fmt.Errorf("invalid KML coordinates %s", tuple)
+			}
+			coord[i] = v
+		}
+		hasZ = hasZ || len(coord) == 3
+		coords = append(coords, coord)
+	}
+	return coords, hasZ, nil
+}
+
+// geometries returns all geometries of placemark, nested MultiGeometry is flattened
+func (k kmlGeometries) geometries() ([]*geom.Geometry, error) {
+	var res []*geom.Geometry
+	for _, p := range k.Points {
+		coords, hasZ, err := parseKMLCoordinates(p.Coordinates)
+		if err != nil {
+			return nil, err
+		}
+		g := &geom.Geometry{Type: geom.Point, HasZ: hasZ}
+		if len(coords) > 0 {
+			g.Point = coords[0]
+		}
+		res = append(res, g)
+	}
+	for _, lines := range [][]kmlCoordinates{k.LineStrings, k.LinearRings} {
+		for _, l := range lines {
+			coords, hasZ, err := parseKMLCoordinates(l.Coordinates)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, &geom.Geometry{Type: geom.LineString, HasZ: hasZ, Line: coords})
+		}
+	}
+	for _, p := range k.Polygons {
+		outer, hasZ, err := parseKMLCoordinates(p.Outer.Coordinates)
+		if err != nil {
+			return nil, err
+		}
+		g := &geom.Geometry{Type: geom.Polygon, HasZ: hasZ, Rings: [][]geom.Coord{outer}}
+		for _, inner := range p.Inner {
+			ring, hasZ, err := parseKMLCoordinates(inner.Coordinates)
+			if err != nil {
+				return nil, err
+			}
+			g.HasZ = g.HasZ || hasZ
+			g.Rings = append(g.Rings, ring)
+		}
+		res = append(res, g)
+	}
+	for _, m := range k.MultiGeometries {
+		nested, err := m.geometries()
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, nested...)
+	}
+	return res, nil
+}
+
+// collect geometries to one, multi geometry when all parts have the same type
+func collect(geometries []*geom.Geometry) *geom.Geometry {
+	switch len(geometries) {
+	case 0:
+		return nil
+	case 1:
+		return geometries[0]
+	}
+	sameType := true
+	hasZ := false
+	for _, g := range geometries {
+		sameType = sameType && g.Type == geometries[0].Type
+		hasZ = hasZ || g.HasZ
+	}
+	if !sameType {
+		return &geom.Geometry{Type: geom.GeometryCollection, HasZ: hasZ, Geometries: geometries}
+	}
+	res := &geom.Geometry{HasZ: hasZ}
+	for _, g := range geometries {
+		switch g.Type {
+		case geom.Point:
+			res.Type = geom.MultiPoint
+			if g.Point != nil {
+				res.Line = append(res.Line, g.Point)
+			}
+		case geom.LineString:
+			res.Type = geom.MultiLineString
+			res.Rings = append(res.Rings, g.Line)
+		case geom.Polygon:
+			res.Type = geom.MultiPolygon
+			res.Polygons = append(res.Polygons, g.Rings)
+		}
+	}
+	return res
+}
+
+// KMLToGeoJSON converts placemarks of KML document; KML coordinates are always WGS84
+func KMLToGeoJSON(ctx context.Context, r io.Reader, w io.Writer) (*Result, error) {
+	fw, err := newFeatureWriter(w)
+	if err != nil {
+		return nil, err
+	}
+	fw.addColumn("name", "string")
+	fw.addColumn("description", "string")
+	decoder := xml.NewDecoder(r)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse KML: %w", err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "Placemark" {
+			continue
+		}
+		var placemark kmlPlacemark
+		if err := decoder.DecodeElement(&placemark, &start); err != nil {
+			return nil, fmt.Errorf("cannot parse KML placemark: %w", err)
+		}
+		geometries, err := placemark.geometries()
+		if err != nil {
+			return nil, fmt.Errorf("placemark %d: %w", fw.result.RowCount+1, err)
+		}
+		properties := map[string]interface{}{
+			"name":        placemark.Name,
+			"description": placemark.Description,
+		}
+		for _, d := range placemark.Data {
+			fw.addColumn(d.Name, "string")
+			properties[d.Name] = d.Value
+		}
+		for _, d := range placemark.SimpleData {
+			fw.addColumn(d.Name, "string")
+			properties[d.Name] = d.Value
+		}
+		if err := fw.write(collect(geometries), properties); err != nil {
+			return nil, err
+		}
+	}
+	return fw.close()
+}
+
+// KMZToGeoJSON converts main KML document of KMZ archive, doc.kml or the first .kml at archive root
+func KMZToGeoJSON(ctx context.Context, r io.ReaderAt, size int64, w io.Writer) (*Result, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read KMZ archive: %w", err)
+	}
+	var doc *zip.File
+	for _, f := range archive.File {
+		if !strings.EqualFold(path.Ext(f.Name), ".kml") {
+			continue
+		}
+		if strings.EqualFold(f.Name, "doc.kml") {
+			doc = f
+			break
+		}
+		if doc == nil || (strings.Contains(doc.Name, "/") && !strings.Contains(f.Name, "/")) {
+			doc = f
+		}
+	}
+	if doc == nil {
+		return nil, fmt.Errorf("no .kml file in KMZ archive")
+	}
+	kml, err := doc.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer kml.Close()
+	return KMLToGeoJSON(ctx, kml, w)
+}
@@ -0,0 +1,203 @@
+package convert
+
+import (
+	"context"
+	"dekart/src/server/geom"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v10/arrow"
+	"github.com/apache/arrow/go/v10/arrow/array"
+	"github.com/apache/arrow/go/v10/arrow/memory"
+	"github.com/apache/arrow/go/v10/parquet"
+	"github.com/apache/arrow/go/v10/parquet/file"
+	"github.com/apache/arrow/go/v10/parquet/pqarrow"
+)
+
+// rows are read from parquet in batches of this size
+const parquetBatchSize = 64 * 1024
+
+// geoParquetMetadata is "geo" key value metadata of GeoParquet files
+type geoParquetMetadata struct {
+	Columns map[string]struct {
+		Encoding string `json:"encoding"`
+	} `json:"columns"`
+}
+
+// geometryColumns returns names of WKB encoded GeoParquet geometry columns
+func geometryColumns(reader *file.Reader) map[string]bool {
+	columns := make(map[string]bool)
+	value := reader.MetaData().KeyValueMetadata().FindValue("geo")
+	if value == nil {
+		return columns
+	}
+	var metadata geoParquetMetadata
+	if err := json.Unmarshal([]byte(*value), &metadata); err != nil {
+		return columns
+	}
+	for name, column := range metadata.Columns {
+		if column.Encoding == "" || strings.EqualFold(column.Encoding, "WKB") {
+			columns[name] = true
+		}
+	}
+	return columns
+}
+
+// ParquetToCSV converts parquet file to CSV with header; GeoParquet geometries are written as WKT
+func ParquetToCSV(ctx context.Context, r parquet.ReaderAtSeeker, w io.Writer) (*Result, error) {
+	reader, err := file.NewParquetReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read parquet file: %w", err)
+	}
+	defer reader.Close()
+	geometries := geometryColumns(reader)
+	fileReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{BatchSize: parquetBatchSize}, memory.DefaultAllocator)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read parquet file: %w", err)
+	}
+	recordReader, err := fileReader.GetRecordReader(ctx, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read parquet file: %w", err)
+	}
+	defer recordReader.Release()
+
+	schema := recordReader.Schema()
+	result := &Result{
+		Schema: make([]Column, len(schema.Fields())),
+	}
+	header := make([]string, len(schema.Fields()))
+	isGeometry := make([]bool, len(schema.Fields()))
+	for i, field := range schema.Fields() {
+		header[i] = field.Name
+		isGeometry[i] = geometries[field.Name]
+		result.Schema[i] = Column{
+			Name:     field.Name,
+			Type:     field.Type.String(),
+			Nullable: field.Nullable,
+		}
+		if isGeometry[i] {
+			result.Schema[i].Type = "geometry"
+			result.Schema[i].Geometry = true
+		}
+	}
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(header); err != nil {
+		return nil, err
+	}
+	row := make([]string, len(header))
+	for recordReader.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		record := recordReader.Record()
+		for i := 0; i < int(record.NumRows()); i++ {
+			for j, column := range record.Columns() {
+				row[j], err = formatValue(column, i, isGeometry[j])
+				if err != nil {
+					return nil, fmt.Errorf("row %d, column %s: %w", result.RowCount+1, header[j], err)
+				}
+			}
+			if err := csvWriter.Write(row); err != nil {
+				return nil, err
+			}
+			result.RowCount++
+		}
+	}
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func formatDecimal(n *big.Int, scale int32) string {
+	if scale <= 0 {
+		return new(big.Int).Mul(n, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-scale)), nil)).String()
+	}
+	sign := ""
+	digits := n.String()
+	if n.Sign() < 0 {
+		sign = "-"
+		digits = digits[1:]
+	}
+	for len(digits) <= int(scale) {
+		digits = "0" + digits
+	}
+	return sign + digits[:len(digits)-int(scale)] + "." + digits[len(digits)-int(scale):]
+}
+
+// formatValue of arrow array at index i as CSV cell; nulls are empty cells
+func formatValue(arr arrow.Array, i int, isGeometry bool) (string, error) {
+	if arr.IsNull(i) {
+		return "", nil
+	}
+	switch a := arr.(type) {
+	case *array.Boolean:
+		return strconv.FormatBool(a.Value(i)), nil
+	case *array.Int8:
+		return strconv.FormatInt(int64(a.Value(i)), 10), nil
+	case *array.Int16:
+		return strconv.FormatInt(int64(a.Value(i)), 10), nil
+	case *array.Int32:
+		return strconv.FormatInt(int64(a.Value(i)), 10), nil
+	case *array.Int64:
+		return strconv.FormatInt(a.Value(i), 10), nil
+	case *array.Uint8:
+		return strconv.FormatUint(uint64(a.Value(i)), 10), nil
+	case *array.Uint16:
+		return strconv.FormatUint(uint64(a.Value(i)), 10), nil
+	case *array.Uint32:
+		return strconv.FormatUint(uint64(a.Value(i)), 10), nil
+	case *array.Uint64:
+		return strconv.FormatUint(a.Value(i), 10), nil
+	case *array.Float32:
+		return strconv.FormatFloat(float64(a.Value(i)), 'f', -1, 32), nil
+	case *array.Float64:
+		return strconv.FormatFloat(a.Value(i), 'f', -1, 64), nil
+	case *array.String:
+		return a.Value(i), nil
+	case *array.Binary:
+		if isGeometry {
+			g, err := geom.ParseWKB(a.Value(i))
+			if err != nil {
+				return "", err
+			}
+			return g.WKT(), nil
+		}
+		return base64.StdEncoding.EncodeToString(a.Value(i)), nil
+	case *array.Timestamp:
+		unit := a.DataType().(*arrow.TimestampType).Unit
+		return a.Value(i).ToTime(unit).Format(time.RFC3339Nano), nil
+	case *array.Date32:
+		return a.Value(i).ToTime().Format("2006-01-02"), nil
+	case *array.Date64:
+		return a.Value(i).ToTime().Format("2006-01-02"), nil
+	case *array.Time32:
+		unit := a.DataType().(*arrow.Time32Type).Unit
+		return a.Value(i).ToTime(unit).Format("15:04:05.999999999"), nil
+	case *array.Time64:
+		unit := a.DataType().(*arrow.Time64Type).Unit
+		return a.Value(i).ToTime(unit).Format("15:04:05.999999999"), nil
+	case *array.Decimal128:
+		scale := a.DataType().(*arrow.Decimal128Type).Scale
+		return formatDecimal(a.Value(i).BigInt(), scale), nil
+	case *array.Dictionary:
+		return formatValue(a.Dictionary(), a.GetValueIndex(i), isGeometry)
+	default:
+		// lists, structs and maps are written as JSON
+		slice := array.NewSlice(arr, int64(i), int64(i+1))
+		defer slice.Release()
+		b, err := json.Marshal(slice)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(strings.TrimPrefix(string(b), "["), "]"), nil
+	}
+}
@@ -0,0 +1,106 @@
+package convert
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/apache/arrow/go/v10/arrow"
+	"github.com/apache/arrow/go/v10/arrow/array"
+	"github.com/apache/arrow/go/v10/arrow/decimal128"
+	"github.com/apache/arrow/go/v10/arrow/memory"
+	"github.com/apache/arrow/go/v10/parquet"
+	"github.com/apache/arrow/go/v10/parquet/pqarrow"
+	"github.com/stretchr/testify/require"
+)
+
+func writeParquet(t *testing.T, metadata map[string]string) []byte {
+	var keys, values []string
+	for k, v := range metadata {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	md := arrow.NewMetadata(keys, values)
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "count", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "price", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}, Nullable: true},
+		{Name: "geometry", Type: arrow.BinaryTypes.Binary, Nullable: true},
+	}, &md)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+	builder.Field(0).(*array.StringBuilder).AppendValues([]string{"a, b", ""}, []bool{true, false})
+	builder.Field(1).(*array.Int64Builder).AppendValues([]int64{1, -2}, nil)
+	builder.Field(2).(*array.Decimal128Builder).Append(decimal128.FromI64(-105))
+	builder.Field(2).(*array.Decimal128Builder).AppendNull()
+	point, err := hex.DecodeString("0101000000000000000000f03f0000000000000040")
+	require.NoError(t, err)
+	builder.Field(3).(*array.BinaryBuilder).AppendValues([][]byte{point, nil}, []bool{true, false})
+	record := builder.NewRecord()
+	defer record.Release()
+
+	var buf bytes.Buffer
+	writer, err := pqarrow.NewFileWriter(schema, &buf, parquet.NewWriterProperties(), pqarrow.NewArrowWriterProperties())
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(record))
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func TestParquetToCSV(t *testing.T) {
+	data := writeParquet(t, nil)
+	var out bytes.Buffer
+	result, err := ParquetToCSV(context.Background(), bytes.NewReader(data), &out)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), result.RowCount)
+	require.Equal(t, []Column{
+		{Name: "name", Type: "utf8", Nullable: true},
+		{Name: "count", Type: "int64", Nullable: false},
+		{Name: "price", Type: "decimal(10, 2)", Nullable: true},
+		{Name: "geometry", Type: "binary", Nullable: true},
+	}, result.Schema)
+	require.Equal(t, "name,count,price,geometry\n\"a, b\",1,-1.05,AQEAAAAAAAAAAADwPwAAAAAAAABA\n,-2,,\n", out.String())
+}
+
+func TestGeoParquetToCSV(t *testing.T) {
+	data := writeParquet(t, map[string]string{
+		"geo": `{"version":"1.0.0","primary_column":"geometry","columns":{"geometry":{"encoding":"WKB"}}}`,
+	})
+	var out bytes.Buffer
+	result, err := ParquetToCSV(context.Background(), bytes.NewReader(data), &out)
+	require.NoError(t, err)
+	require.Equal(t, "geometry", result.Schema[3].Type)
+	require.Equal(t, "name,count,price,geometry\n\"a, b\",1,-1.05,POINT (1 2)\n,-2,,\n", out.String())
+}
+
+func TestParquetToCSVInvalidFile(t *testing.T) {
+	var out bytes.Buffer
+	_, err := ParquetToCSV(context.Background(), bytes.NewReader([]byte("name,count\n")), &out)
+	require.Error(t, err)
+}
+
+func TestParquetWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, []Column{
+		{Name: "name", Type: "utf8"},
+		{Name: "count", Type: "int64"},
+		{Name: "price", Type: "float64"},
+		{Name: "open", Type: "bool"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, w.Write([]string{"a, b", "1", "2.5", "true"}))
+	require.NoError(t, w.Write([]string{"", "", "", ""}))
+	require.Error(t, w.Write([]string{"c"}))
+	require.Error(t, w.Write([]string{"c", "x", "1", "true"}))
+	require.NoError(t, w.Close())
+
+	var out bytes.Buffer
+	result, err := ParquetToCSV(context.Background(), bytes.NewReader(buf.Bytes()), &out)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), result.RowCount)
+	require.Equal(t, []string{"utf8", "int64", "float64", "bool"}, []string{
+		result.Schema[0].Type, result.Schema[1].Type, result.Schema[2].Type, result.Schema[3].Type,
+	})
+	require.Equal(t, "name,count,price,open\n\"a, b\",1,2.5,true\n,,,\n", out.String())
+}
@@ -0,0 +1,143 @@
+package convert
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/apache/arrow/go/v10/arrow"
+	"github.com/apache/arrow/go/v10/arrow/array"
+	"github.com/apache/arrow/go/v10/arrow/memory"
+	"github.com/apache/arrow/go/v10/parquet"
+	"github.com/apache/arrow/go/v10/parquet/compress"
+	"github.com/apache/arrow/go/v10/parquet/pqarrow"
+)
+
+// ParquetWriter encodes rows of string values as Parquet; values are parsed to column types
+// int64, float64 and bool, empty value of these columns is null; other columns are written as strings
+type ParquetWriter struct {
+	columns []Column
+	builder *array.RecordBuilder
+	writer  *pqarrow.FileWriter
+	rows    int
+}
+
+// writerOnly hides Close of underlying writer, pqarrow closes writer it is given
+type writerOnly struct {
+	io.Writer
+}
+
+func arrowType(columnType string) arrow.DataType {
+	switch columnType {
+	case "int64":
+		return arrow.PrimitiveTypes.Int64
+	case "float64":
+		return arrow.PrimitiveTypes.Float64
+	case "bool":
+		return arrow.FixedWidthTypes.Boolean
+	}
+	return arrow.BinaryTypes.String
+}
+
+// NewParquetWriter with schema of columns; Close must be called to write file footer, w is not closed
+func NewParquetWriter(w io.Writer, columns []Column) (*ParquetWriter, error) {
+	fields := make([]arrow.Field, len(columns))
+	for i, c := range columns {
+		fields[i] = arrow.Field{Name: c.Name, Type: arrowType(c.Type), Nullable: true}
+	}
+	schema := arrow.NewSchema(fields, nil)
+	writer, err := pqarrow.NewFileWriter(
+		schema,
+		writerOnly{w},
+		parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy)),
+		pqarrow.DefaultWriterProps(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &ParquetWriter{
+		columns: columns,
+		builder: array.NewRecordBuilder(memory.DefaultAllocator, schema),
+		writer:  writer,
+	}, nil
+}
+
+// Write row, rows are buffered and written in row groups of parquetBatchSize;
+// row with invalid value is not written
+func (p *ParquetWriter) Write(row []string) error {
+	if len(row) != len(p.columns) {
+		return fmt.Errorf("expected %d values, got %d", len(p.columns), len(row))
+	}
+	values := make([]interface{}, len(row))
+	for i, value := range row {
+		v, err := parseValue(p.builder.Field(i), value)
+		if err != nil {
+			return fmt.Errorf("column %s: %w", p.columns[i].Name, err)
+		}
+		values[i] = v
+	}
+	for i, v := range values {
+		appendValue(p.builder.Field(i), v)
+	}
+	p.rows++
+	if p.rows >= parquetBatchSize {
+		return p.flush()
+	}
+	return nil
+}
+
+// parseValue to type of builder; nil is null
+func parseValue(builder array.Builder, value string) (interface{}, error) {
+	if _, ok := builder.(*array.StringBuilder); ok {
+		return value, nil
+	}
+	if value == "" {
+		return nil, nil
+	}
+	switch builder.(type) {
+	case *array.Int64Builder:
+		return strconv.ParseInt(value, 10, 64)
+	case *array.Float64Builder:
+		return strconv.ParseFloat(value, 64)
+	case *array.BooleanBuilder:
+		return strconv.ParseBool(value)
+	}
+	return nil, fmt.Errorf("unsupported builder %T", builder)
+}
+
+func appendValue(builder array.Builder, v interface{}) {
+	if v == nil {
+		builder.AppendNull()
+		return
+	}
+	switch b := builder.(type) {
+	case *array.StringBuilder:
+		b.Append(v.(string))
+	case *array.Int64Builder:
+		b.Append(v.(int64))
+	case *array.Float64Builder:
+		b.Append(v.(float64))
+	case *array.BooleanBuilder:
+		b.Append(v.(bool))
+	}
+}
+
+func (p *ParquetWriter) flush() error {
+	if p.rows == 0 {
+		return nil
+	}
+	record := p.builder.NewRecord()
+	defer record.Release()
+	p.rows = 0
+	return p.writer.Write(record)
+}
+
+// Close writes buffered rows and file footer
+func (p *ParquetWriter) Close() error {
+	defer p.builder.Release()
+	if err := p.flush(); err != nil {
+		p.writer.Close()
+		return err
+	}
+	return p.writer.Close()
+}
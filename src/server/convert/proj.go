@@ -0,0 +1,607 @@
+package convert
+
+import (
+	"dekart/src/server/geom"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Projection converts coordinates of source coordinate reference system to WGS84 longitude and latitude
+type Projection interface {
+	ToWGS84(x, y float64) (lon, lat float64, err error)
+}
+
+// WGS84 is identity projection for data already in longitude and latitude
+var WGS84 Projection = geographic{}
+
+// Reproject geometry to WGS84 in place
+func Reproject(g *geom.Geometry, p Projection) error {
+	if p == WGS84 {
+		return nil
+	}
+	return g.Transform(func(c geom.Coord) (geom.Coord, error) {
+		lon, lat, err := p.ToWGS84(c[0], c[1])
+		if err != nil {
+			return nil, err
+		}
+		res := geom.Coord{lon, lat}
+		return append(res, c[2:]...), nil
+	})
+}
+
+// wktNode is KEYWORD["value",1,CHILD[...]] element of well known text CRS definition
+type wktNode struct {
+	keyword  string
+	values   []string
+	children []*wktNode
+}
+
+// child returns first child with one of keywords
+func (n *wktNode) child(keywords ...string) *wktNode {
+	for _, c := range n.children {
+		for _, k := range keywords {
+			if c.keyword == k {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+func (n *wktNode) float(i int) (float64, error) {
+	if i >= len(n.values) {
+		return 0, fmt.Errorf("%s has no value %d", n.keyword, i)
+	}
+	return strconv.ParseFloat(n.values[i], 64)
+}
+
+type wktParser struct {
+	s   string
+	pos int
+}
+
+func (p *wktParser) skipSpace() {
+	for p.pos < len(p.s) && unicode.IsSpace(rune(p.s[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *wktParser) parseNode() (*wktNode, error) {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && (unicode.IsLetter(rune(p.s[p.pos])) || unicode.IsDigit(rune(p.s[p.pos])) || p.s[p.pos] == '_') {
+		p.pos++
+	}
+	node := &wktNode{keyword: strings.ToUpper(p.s[start:p.pos])}
+	p.skipSpace()
+	if p.pos >= len(p.s) || (p.s[p.pos] != '[' && p.s[p.pos] != '(') {
+		return nil, fmt.Errorf("expected [ after %s at %d", node.keyword, p.pos)
+	}
+	p.pos++
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.s) {
+			return nil, fmt.Errorf("unexpected end of WKT")
+		}
+		switch c := p.s[p.pos]; {
+		case c == ']' || c == ')':
+			p.pos++
+			return node, nil
+		case c == ',':
+			p.pos++
+		case c == '"':
+			end := strings.IndexByte(p.s[p.pos+1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string in WKT")
+			}
+			node.values = append(node.values, p.s[p.pos+1:p.pos+1+end])
+			p.pos += end + 2
+		default:
+			start := p.pos
+			for p.pos < len(p.s) && !strings.ContainsRune(",[]()", rune(p.s[p.pos])) {
+				p.pos++
+			}
+			token := strings.TrimSpace(p.s[start:p.pos])
+			if p.pos < len(p.s) && (p.s[p.pos] == '[' || p.s[p.pos] == '(') {
+				p.pos = start
+				child, err := p.parseNode()
+				if err != nil {
+					return nil, err
+				}
+				node.children = append(node.children, child)
+			} else {
+				node.values = append(node.values, token)
+			}
+		}
+	}
+}
+
+func parseWKT(s string) (*wktNode, error) {
+	p := &wktParser{s: s}
+	node, err := p.parseNode()
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// ellipsoid with semi-major axis and squared eccentricity
+type ellipsoid struct {
+	a  float64
+	e2 float64
+}
+
+var wgs84Ellipsoid = ellipsoid{a: 6378137, e2: 0.0066943799901413165}
+
+func (el ellipsoid) e() float64 {
+	return math.Sqrt(el.e2)
+}
+
+// helmert transformation parameters of TOWGS84 node
+type helmert struct {
+	dx, dy, dz float64
+	rx, ry, rz float64 // radians
+	s          float64 // scale difference
+}
+
+// geographic coordinates in datum of ellipsoid; datum shift is applied only when TOWGS84 is defined
+type geographic struct {
+	ellipsoid     ellipsoid
+	toWGS84       *helmert
+	primeMeridian float64 // degrees
+	angularUnit   float64 // radians in unit, 0 means degrees
+}
+
+func (g geographic) toDegrees(v float64) float64 {
+	if g.angularUnit == 0 {
+		return v
+	}
+	return v * g.angularUnit * 180 / math.Pi
+}
+
+func (g geographic) ToWGS84(x, y float64) (float64, float64, error) {
+	return g.datumToWGS84(g.toDegrees(x)+g.primeMeridian, g.toDegrees(y))
+}
+
+// datumToWGS84 converts longitude and latitude in degrees via geocentric coordinates
+func (g geographic) datumToWGS84(lon, lat float64) (float64, float64, error) {
+	if g.toWGS84 == nil {
+		return lon, lat, nil
+	}
+	h := g.toWGS84
+	phi := lat * math.Pi / 180
+	lambda := lon * math.Pi / 180
+	el := g.ellipsoid
+	n := el.a / math.Sqrt(1-el.e2*math.Sin(phi)*math.Sin(phi))
+	x := n * math.Cos(phi) * math.Cos(lambda)
+	y := n * math.Cos(phi) * math.Sin(lambda)
+	z := n * (1 - el.e2) * math.Sin(phi)
+	// position vector convention, as used by EPSG and OGC WKT
+	m := 1 + h.s
+	x, y, z = h.dx+m*(x-h.rz*y+h.ry*z), h.dy+m*(h.rz*x+y-h.rx*z), h.dz+m*(-h.ry*x+h.rx*y+z)
+
+	w := wgs84Ellipsoid
+	p := math.Hypot(x, y)
+	lambda = math.Atan2(y, x)
+	phi = math.Atan2(z, p*(1-w.e2))
+	for i := 0; i < 10; i++ {
+		n = w.a / math.Sqrt(1-w.e2*math.Sin(phi)*math.Sin(phi))
+		h := p/math.Cos(phi) - n
+		next := math.Atan2(z, p*(1-w.e2*n/(n+h)))
+		if math.Abs(next-phi) < 1e-12 {
+			phi = next
+			break
+		}
+		phi = next
+	}
+	return lambda * 180 / math.Pi, phi * 180 / math.Pi, nil
+}
+
+// inverse converts projected meters relative to false origin to radians
+type inverse func(x, y float64) (lambda, phi float64)
+
+// projected coordinates
+type projected struct {
+	geographic   geographic
+	inverse      inverse
+	falseEasting float64
+	falseNorth   float64
+	linearUnit   float64 // meters in unit
+	centralLon   float64 // radians
+}
+
+func (p projected) ToWGS84(x, y float64) (float64, float64, error) {
+	lambda, phi := p.inverse((x-p.falseEasting)*p.linearUnit, (y-p.falseNorth)*p.linearUnit)
+	if math.IsNaN(lambda) || math.IsNaN(phi) {
+		return 0, 0, fmt.Errorf("cannot reproject %v %v", x, y)
+	}
+	lon := (lambda+p.centralLon)*180/math.Pi + p.geographic.primeMeridian
+	lon = math.Mod(lon+540, 360) - 180
+	return p.geographic.datumToWGS84(lon, phi*180/math.Pi)
+}
+
+// meridianArc is distance from equator to latitude phi
+func (el ellipsoid) meridianArc(phi float64) float64 {
+	e2, e4, e6 := el.e2, el.e2*el.e2, el.e2*el.e2*el.e2
+	return el.a * ((1-e2/4-3*e4/64-5*e6/256)*phi -
+		(3*e2/8+3*e4/32+45*e6/1024)*math.Sin(2*phi) +
+		(15*e4/256+45*e6/1024)*math.Sin(4*phi) -
+		(35*e6/3072)*math.Sin(6*phi))
+}
+
+// transverseMercator inverse, Snyder "Map Projections: A Working Manual" p. 63
+func transverseMercator(el ellipsoid, lat0, k0 float64) inverse {
+	e2 := el.e2
+	ep2 := e2 / (1 - e2)
+	m0 := el.meridianArc(lat0)
+	e1 := (1 - math.Sqrt(1-e2)) / (1 + math.Sqrt(1-e2))
+	return func(x, y float64) (float64, float64) {
+		m := m0 + y/k0
+		mu := m / (el.a * (1 - e2/4 - 3*e2*e2/64 - 5*e2*e2*e2/256))
+		phi1 := mu + (3*e1/2-27*math.Pow(e1, 3)/32)*math.Sin(2*mu) +
+			(21*e1*e1/16-55*math.Pow(e1, 4)/32)*math.Sin(4*mu) +
+			(151*math.Pow(e1, 3)/96)*math.Sin(6*mu) +
+			(1097*math.Pow(e1, 4)/512)*math.Sin(8*mu)
+		sin, cos, tan := math.Sin(phi1), math.Cos(phi1), math.Tan(phi1)
+		c1 := ep2 * cos * cos
+		t1 := tan * tan
+		n1 := el.a / math.Sqrt(1-e2*sin*sin)
+		r1 := el.a * (1 - e2) / math.Pow(1-e2*sin*sin, 1.5)
+		d := x / (n1 * k0)
+		phi := phi1 - (n1*tan/r1)*(d*d/2-
+			(5+3*t1+10*c1-4*c1*c1-9*ep2)*math.Pow(d, 4)/24+
+			(61+90*t1+298*c1+45*t1*t1-252*ep2-3*c1*c1)*math.Pow(d, 6)/720)
+		lambda := (d - (1+2*t1+c1)*math.Pow(d, 3)/6 +
+			(5-2*c1+28*t1-3*c1*c1+8*ep2+24*t1*t1)*math.Pow(d, 5)/120) / cos
+		return lambda, phi
+	}
+}
+
+// latitudeFromT solves t = tan(pi/4 - phi/2) / ((1 - e sin phi) / (1 + e sin phi))^(e/2) for phi
+func latitudeFromT(e, t float64) float64 {
+	phi := math.Pi/2 - 2*math.Atan(t)
+	for i := 0; i < 15; i++ {
+		es := e * math.Sin(phi)
+		next := math.Pi/2 - 2*math.Atan(t*math.Pow((1-es)/(1+es), e/2))
+		if math.Abs(next-phi) < 1e-12 {
+			return next
+		}
+		phi = next
+	}
+	return phi
+}
+
+func tFunc(e, phi float64) float64 {
+	es := e * math.Sin(phi)
+	return math.Tan(math.Pi/4-phi/2) / math.Pow((1-es)/(1+es), e/2)
+}
+
+func mFunc(el ellipsoid, phi float64) float64 {
+	sin := math.Sin(phi)
+	return math.Cos(phi) / math.Sqrt(1-el.e2*sin*sin)
+}
+
+// mercator inverse, Snyder p. 44; k0 is scale at equator
+func mercator(el ellipsoid, k0 float64) inverse {
+	e := el.e()
+	return func(x, y float64) (float64, float64) {
+		return x / (el.a * k0), latitudeFromT(e, math.Exp(-y/(el.a*k0)))
+	}
+}
+
+// lambertConformalConic inverse, Snyder p. 107; lat1 equals lat2 for one standard parallel variant
+func lambertConformalConic(el ellipsoid, lat0, lat1, lat2, k0 float64) inverse {
+	e := el.e()
+	m1, m2 := mFunc(el, lat1), mFunc(el, lat2)
+	t0, t1, t2 := tFunc(e, lat0), tFunc(e, lat1), tFunc(e, lat2)
+	n := math.Sin(lat1)
+	if lat1 != lat2 {
+		n = (math.Log(m1) - math.Log(m2)) / (math.Log(t1) - math.Log(t2))
+	}
+	f := m1 / (n * math.Pow(t1, n))
+	rho0 := el.a * k0 * f * math.Pow(t0, n)
+	sign := 1.0
+	if n < 0 {
+		sign = -1
+	}
+	return func(x, y float64) (float64, float64) {
+		rho := sign * math.Hypot(x, rho0-y)
+		theta := math.Atan2(sign*x, sign*(rho0-y))
+		t := math.Pow(rho/(el.a*k0*f), 1/n)
+		return theta / n, latitudeFromT(e, t)
+	}
+}
+
+func qFunc(el ellipsoid, phi float64) float64 {
+	sin := math.Sin(phi)
+	if el.e2 == 0 {
+		return 2 * sin
+	}
+	e := el.e()
+	return (1 - el.e2) * (sin/(1-el.e2*sin*sin) - math.Log((1-e*sin)/(1+e*sin))/(2*e))
+}
+
+// albersEqualArea inverse, Snyder p. 101
+func albersEqualArea(el ellipsoid, lat0, lat1, lat2 float64) inverse {
+	e := el.e()
+	m1, m2 := mFunc(el, lat1), mFunc(el, lat2)
+	q0, q1, q2 := qFunc(el, lat0), qFunc(el, lat1), qFunc(el, lat2)
+	n := math.Sin(lat1)
+	if lat1 != lat2 {
+		n = (m1*m1 - m2*m2) / (q2 - q1)
+	}
+	c := m1*m1 + n*q1
+	rho0 := el.a * math.Sqrt(c-n*q0) / n
+	sign := 1.0
+	if n < 0 {
+		sign = -1
+	}
+	return func(x, y float64) (float64, float64) {
+		rho := sign * math.Hypot(x, rho0-y)
+		theta := math.Atan2(sign*x, sign*(rho0-y))
+		q := (c - rho*rho*n*n/(el.a*el.a)) / n
+		phi := math.Asin(q / 2)
+		if el.e2 > 0 {
+			for i := 0; i < 15; i++ {
+				sin := math.Sin(phi)
+				one := 1 - el.e2*sin*sin
+				next := phi + one*one/(2*math.Cos(phi))*
+					(q/(1-el.e2)-sin/one+math.Log((1-e*sin)/(1+e*sin))/(2*e))
+				if math.Abs(next-phi) < 1e-12 {
+					phi = next
+					break
+				}
+				phi = next
+			}
+		}
+		return theta / n, phi
+	}
+}
+
+func parseGeographic(n *wktNode) (geographic, error) {
+	g := geographic{ellipsoid: wgs84Ellipsoid}
+	if n == nil {
+		return g, nil
+	}
+	datum := n.child("DATUM")
+	if datum == nil {
+		datum = n
+	}
+	if spheroid := datum.child("SPHEROID"); spheroid != nil {
+		a, err := spheroid.float(1)
+		if err != nil {
+			return g, err
+		}
+		invf, err := spheroid.float(2)
+		if err != nil {
+			return g, err
+		}
+		g.ellipsoid = ellipsoid{a: a}
+		if invf != 0 {
+			f := 1 / invf
+			g.ellipsoid.e2 = f * (2 - f)
+		}
+	}
+	if towgs84 := datum.child("TOWGS84"); towgs84 != nil {
+		values := make([]float64, 7)
+		for i := range towgs84.values {
+			if i >= len(values) {
+				break
+			}
+			v, err := towgs84.float(i)
+			if err != nil {
+				return g, err
+			}
+			values[i] = v
+		}
+		arcsec := math.Pi / 180 / 3600
+		h := &helmert{
+			dx: values[0], dy: values[1], dz: values[2],
+			rx: values[3] * arcsec, ry: values[4] * arcsec, rz: values[5] * arcsec,
+			s: values[6] / 1e6,
+		}
+		if *h != (helmert{}) {
+			g.toWGS84 = h
+		}
+	}
+	if primem := n.child("PRIMEM"); primem != nil {
+		v, err := primem.float(1)
+		if err != nil {
+			return g, err
+		}
+		g.primeMeridian = v
+	}
+	if unit := n.child("UNIT"); unit != nil {
+		v, err := unit.float(1)
+		if err != nil {
+			return g, err
+		}
+		if math.Abs(v-math.Pi/180) > 1e-12 {
+			g.angularUnit = v
+		}
+	}
+	return g, nil
+}
+
+// normalizeName makes projection and parameter names of OGC and ESRI comparable
+func normalizeName(s string) string {
+	s = strings.ToLower(s)
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// projection parameters with aliases; angles are in degrees
+var parameterAliases = map[string][]string{
+	"false_easting":       {"falseeasting", "eastingatfalseorigin", "eastingatprojectioncentre"},
+	"false_northing":      {"falsenorthing", "northingatfalseorigin", "northingatprojectioncentre"},
+	"central_meridian":    {"centralmeridian", "longitudeofnaturalorigin", "longitudeoffalseorigin", "longitudeofcenter", "longitudeoforigin"},
+	"latitude_of_origin":  {"latitudeoforigin", "latitudeofnaturalorigin", "latitudeoffalseorigin", "latitudeofcenter"},
+	"scale_factor":        {"scalefactor", "scalefactoratnaturalorigin"},
+	"standard_parallel_1": {"standardparallel1", "latitudeof1ststandardparallel"},
+	"standard_parallel_2": {"standardparallel2", "latitudeof2ndstandardparallel"},
+}
+
+func projectionParameters(n *wktNode) map[string]float64 {
+	params := make(map[string]float64)
+	nodes := n.children
+	if conversion := n.child("CONVERSION"); conversion != nil {
+		nodes = conversion.children
+	}
+	for _, c := range nodes {
+		if c.keyword != "PARAMETER" || len(c.values) < 2 {
+			continue
+		}
+		v, err := c.float(1)
+		if err != nil {
+			continue
+		}
+		name := normalizeName(c.values[0])
+		for param, aliases := range parameterAliases {
+			for _, alias := range aliases {
+				if name == alias {
+					params[param] = v
+				}
+			}
+		}
+	}
+	return params
+}
+
+func parseProjected(n *wktNode) (Projection, error) {
+	g, err := parseGeographic(n.child("GEOGCS"))
+	if err != nil {
+		return nil, err
+	}
+	method := n.child("PROJECTION")
+	if method == nil || len(method.values) == 0 {
+		return nil, fmt.Errorf("projection method is not defined")
+	}
+	params := projectionParameters(n)
+	p := projected{
+		geographic:   g,
+		falseEasting: params["false_easting"],
+		falseNorth:   params["false_northing"],
+		linearUnit:   1,
+		centralLon:   params["central_meridian"] * math.Pi / 180,
+	}
+	// WKT1 unit of projected CRS is its last direct UNIT child, unit of GEOGCS is nested
+	for _, c := range n.children {
+		if c.keyword == "UNIT" {
+			if v, err := c.float(1); err == nil && v > 0 {
+				p.linearUnit = v
+			}
+		}
+	}
+	k0, ok := params["scale_factor"]
+	if !ok {
+		k0 = 1
+	}
+	rad := math.Pi / 180
+	lat0 := params["latitude_of_origin"] * rad
+	lat1, ok := params["standard_parallel_1"]
+	if !ok {
+		lat1 = params["latitude_of_origin"]
+	}
+	lat2, ok := params["standard_parallel_2"]
+	if !ok {
+		lat2 = lat1
+	}
+	name := normalizeName(method.values[0])
+	switch {
+	case name == "transversemercator" || name == "gausskruger":
+		p.inverse = transverseMercator(g.ellipsoid, lat0, k0)
+	case strings.Contains(name, "pseudomercator") || strings.Contains(name, "popularvisualisation") || name == "mercatorauxiliarysphere":
+		// web mercator uses spherical formulas on WGS84 ellipsoid
+		p.inverse = mercator(ellipsoid{a: g.ellipsoid.a}, 1)
+	case strings.HasPrefix(name, "mercator"):
+		if _, ok := params["standard_parallel_1"]; ok {
+			k0 = mFunc(g.ellipsoid, lat1*rad)
+		}
+		p.inverse = mercator(g.ellipsoid, k0)
+	case strings.HasPrefix(name, "lambertconformalconic"):
+		if strings.HasSuffix(name, "1sp") {
+			lat1, lat2 = params["latitude_of_origin"], params["latitude_of_origin"]
+		}
+		p.inverse = lambertConformalConic(g.ellipsoid, lat0, lat1*rad, lat2*rad, k0)
+	case strings.HasPrefix(name, "albers"):
+		p.inverse = albersEqualArea(g.ellipsoid, lat0, lat1*rad, lat2*rad)
+	default:
+		return nil, fmt.Errorf("unsupported projection %s, supported projections are %s", method.values[0], supportedProjections)
+	}
+	return p, nil
+}
+
+// supportedProjections is listed in errors, which are shown to user as upload error
+const supportedProjections = "transverse mercator (Gauss-Kruger), web mercator, mercator, lambert conformal conic (1SP and 2SP) and albers equal area"
+
+// ParseProjection from WKT1 coordinate reference system definition in OGC or ESRI (.prj) flavor.
+// Only GEOGCS and PROJCS with projections listed in supportedProjections are handled, WKT2 (GEOGCRS, PROJCRS)
+// is not supported; GeoPackage layers with EPSG code fall back to EPSGProjection.
+func ParseProjection(wkt string) (Projection, error) {
+	n, err := parseWKT(strings.TrimSpace(wkt))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse CRS definition: %w", err)
+	}
+	switch n.keyword {
+	case "GEOGCS":
+		g, err := parseGeographic(n)
+		if err != nil {
+			return nil, err
+		}
+		if g.toWGS84 == nil && g.primeMeridian == 0 && g.angularUnit == 0 {
+			return WGS84, nil
+		}
+		return g, nil
+	case "PROJCS":
+		return parseProjected(n)
+	default:
+		return nil, fmt.Errorf("unsupported CRS %s, only WKT1 GEOGCS and PROJCS definitions are supported", n.keyword)
+	}
+}
+
+// EPSGProjection for codes of WGS84, web mercator and UTM zones which are used without definition
+func EPSGProjection(code int) (Projection, error) {
+	utm := func(zone int, south bool) Projection {
+		p := projected{
+			geographic:   geographic{ellipsoid: wgs84Ellipsoid},
+			inverse:      transverseMercator(wgs84Ellipsoid, 0, 0.9996),
+			falseEasting: 500000,
+			linearUnit:   1,
+			centralLon:   float64(zone*6-183) * math.Pi / 180,
+		}
+		if south {
+			p.falseNorth = 10000000
+		}
+		return p
+	}
+	switch {
+	case code == 4326 || code == 4258 || code == 4269:
+		// ETRS89 and NAD83 differ from WGS84 less than a meter
+		return WGS84, nil
+	case code == 3857 || code == 900913:
+		return projected{
+			geographic: geographic{ellipsoid: wgs84Ellipsoid},
+			inverse:    mercator(ellipsoid{a: wgs84Ellipsoid.a}, 1),
+			linearUnit: 1,
+		}, nil
+	case code > 32600 && code <= 32660:
+		return utm(code-32600, false), nil
+	case code > 32700 && code <= 32760:
+		return utm(code-32700, true), nil
+	case code > 25800 && code <= 25860:
+		// ETRS89 UTM
+		return utm(code-25800, false), nil
+	case code > 26900 && code <= 26923:
+		// NAD83 UTM
+		return utm(code-26900, false), nil
+	default:
+		return nil, fmt.Errorf("unsupported EPSG code %d, without CRS definition only WGS84, web mercator and UTM zones are supported", code)
+	}
+}
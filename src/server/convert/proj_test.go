@@ -0,0 +1,66 @@
+package convert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const utm32WKT = `PROJCS["WGS 84 / UTM zone 32N",GEOGCS["WGS 84",DATUM["WGS_1984",SPHEROID["WGS 84",6378137,298.257223563,AUTHORITY["EPSG","7030"]],AUTHORITY["EPSG","6326"]],PRIMEM["Greenwich",0],UNIT["degree",0.0174532925199433]],PROJECTION["Transverse_Mercator"],PARAMETER["latitude_of_origin",0],PARAMETER["central_meridian",9],PARAMETER["scale_factor",0.9996],PARAMETER["false_easting",500000],PARAMETER["false_northing",0],UNIT["metre",1],AXIS["Easting",EAST],AXIS["Northing",NORTH]]`
+
+// Texas South Central, EPSG Guidance Note 7-2 example
+const lambertWKT = `PROJCS["NAD27 / Texas South Central",GEOGCS["NAD27",DATUM["North_American_Datum_1927",SPHEROID["Clarke 1866",6378206.4,294.9786982138982]],PRIMEM["Greenwich",0],UNIT["degree",0.0174532925199433]],PROJECTION["Lambert_Conformal_Conic_2SP"],PARAMETER["standard_parallel_1",28.38333333333333],PARAMETER["standard_parallel_2",30.28333333333333],PARAMETER["latitude_of_origin",27.83333333333333],PARAMETER["central_meridian",-99],PARAMETER["false_easting",2000000],PARAMETER["false_northing",0],UNIT["US survey foot",0.3048006096012192]]`
+
+// Snyder "Map Projections: A Working Manual" numerical example, ESRI style names
+const albersWKT = `PROJCS["Albers",GEOGCS["GCS_North_American_1927",DATUM["D_North_American_1927",SPHEROID["Clarke_1866",6378206.4,294.9786982]],PRIMEM["Greenwich",0.0],UNIT["Degree",0.0174532925199433]],PROJECTION["Albers"],PARAMETER["False_Easting",0.0],PARAMETER["False_Northing",0.0],PARAMETER["Central_Meridian",-96.0],PARAMETER["Standard_Parallel_1",29.5],PARAMETER["Standard_Parallel_2",45.5],PARAMETER["Latitude_Of_Origin",23.0],UNIT["Meter",1.0]]`
+
+const webMercatorWKT = `PROJCS["WGS_1984_Web_Mercator_Auxiliary_Sphere",GEOGCS["GCS_WGS_1984",DATUM["D_WGS_1984",SPHEROID["WGS_1984",6378137.0,298.257223563]],PRIMEM["Greenwich",0.0],UNIT["Degree",0.0174532925199433]],PROJECTION["Mercator_Auxiliary_Sphere"],PARAMETER["False_Easting",0.0],PARAMETER["False_Northing",0.0],PARAMETER["Central_Meridian",0.0],PARAMETER["Standard_Parallel_1",0.0],PARAMETER["Auxiliary_Sphere_Type",0.0],UNIT["Meter",1.0]]`
+
+func TestParseProjection(t *testing.T) {
+	tests := []struct {
+		name     string
+		wkt      string
+		x, y     float64
+		lon, lat float64
+	}{
+		{name: "transverse mercator", wkt: utm32WKT, x: 691875.63214, y: 6098907.82501, lon: 12, lat: 55},
+		{name: "lambert conformal conic", wkt: lambertWKT, x: 2963503.91, y: 254759.80, lon: -96, lat: 28.5},
+		{name: "albers", wkt: albersWKT, x: 1885472.7, y: 1535925.0, lon: -75, lat: 35},
+		{name: "web mercator", wkt: webMercatorWKT, x: 1113194.9079327357, y: 1118889.9748579594, lon: 10, lat: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseProjection(tt.wkt)
+			require.NoError(t, err)
+			lon, lat, err := p.ToWGS84(tt.x, tt.y)
+			require.NoError(t, err)
+			require.InDelta(t, tt.lon, lon, 1e-5)
+			require.InDelta(t, tt.lat, lat, 1e-5)
+		})
+	}
+}
+
+func TestParseGeographicProjection(t *testing.T) {
+	p, err := ParseProjection(`GEOGCS["GCS_WGS_1984",DATUM["D_WGS_1984",SPHEROID["WGS_1984",6378137.0,298.257223563]],PRIMEM["Greenwich",0.0],UNIT["Degree",0.0174532925199433]]`)
+	require.NoError(t, err)
+	require.Equal(t, WGS84, p)
+
+	_, err = ParseProjection(`PROJCS["Unknown",GEOGCS["WGS 84"],PROJECTION["Polyconic"]]`)
+	require.ErrorContains(t, err, "transverse mercator")
+	_, err = ParseProjection(`PROJCRS["WGS 84 / UTM zone 32N",BASEGEOGCRS["WGS 84"],CONVERSION["UTM zone 32N",METHOD["Transverse Mercator"]]]`)
+	require.ErrorContains(t, err, "WKT1")
+	_, err = ParseProjection(`GEOGCS["broken"`)
+	require.Error(t, err)
+}
+
+func TestEPSGProjection(t *testing.T) {
+	p, err := EPSGProjection(32632)
+	require.NoError(t, err)
+	lon, lat, err := p.ToWGS84(691875.63214, 6098907.82501)
+	require.NoError(t, err)
+	require.InDelta(t, 12, lon, 1e-5)
+	require.InDelta(t, 55, lat, 1e-5)
+
+	_, err = EPSGProjection(2154)
+	require.Error(t, err)
+}
@@ -0,0 +1,489 @@
+package convert
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"dekart/src/server/geom"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// findZipFile returns file with base name and extension, case insensitive
+func findZipFile(files []*zip.File, base string, ext string) *zip.File {
+	for _, f := range files {
+		if strings.EqualFold(f.Name, base+ext) {
+			return f
+		}
+	}
+	return nil
+}
+
+// findShapefile returns base name of the first .shp in archive
+func findShapefile(files []*zip.File) (string, error) {
+	for _, f := range files {
+		if strings.HasPrefix(f.Name, "__MACOSX/") {
+			continue
+		}
+		ext := path.Ext(f.Name)
+		if strings.EqualFold(ext, ".shp") {
+			return strings.TrimSuffix(f.Name, ext), nil
+		}
+	}
+	return "", fmt.Errorf("no .shp file in zip archive")
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	r, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// ShapefileToGeoJSON converts zipped shapefile (.shp, .dbf and optional .prj) to GeoJSON in WGS84;
+// shapefile without .prj is expected to be in WGS84 already
+func ShapefileToGeoJSON(ctx context.Context, r io.ReaderAt, size int64, w io.Writer) (*Result, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read zip archive: %w", err)
+	}
+	base, err := findShapefile(archive.File)
+	if err != nil {
+		return nil, err
+	}
+	projection := WGS84
+	if prjFile := findZipFile(archive.File, base, ".prj"); prjFile != nil {
+		prj, err := readZipFile(prjFile)
+		if err != nil {
+			return nil, err
+		}
+		projection, err = ParseProjection(string(prj))
+		if err != nil {
+			return nil, err
+		}
+	}
+	shpFile, err := findZipFile(archive.File, base, ".shp").Open()
+	if err != nil {
+		return nil, err
+	}
+	defer shpFile.Close()
+	shp := &shpReader{r: bufio.NewReader(shpFile)}
+	if err := shp.readHeader(); err != nil {
+		return nil, err
+	}
+	var dbf *dbfReader
+	if dbfFile := findZipFile(archive.File, base, ".dbf"); dbfFile != nil {
+		dbfReadCloser, err := dbfFile.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer dbfReadCloser.Close()
+		dbf = &dbfReader{r: bufio.NewReader(dbfReadCloser)}
+		if err := dbf.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	fw, err := newFeatureWriter(w)
+	if err != nil {
+		return nil, err
+	}
+	if dbf != nil {
+		for _, field := range dbf.fields {
+			fw.addColumn(field.name, field.columnType())
+		}
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		g, err := shp.readRecord()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read shape %d: %w", shp.records+1, err)
+		}
+		properties := map[string]interface{}{}
+		if dbf != nil {
+			var deleted bool
+			properties, deleted, err = dbf.readRecord()
+			if err != nil {
+				return nil, fmt.Errorf("cannot read attributes of shape %d: %w", shp.records, err)
+			}
+			if deleted {
+				continue
+			}
+		}
+		if g != nil {
+			if err := Reproject(g, projection); err != nil {
+				return nil, err
+			}
+		}
+		if err := fw.write(g, properties); err != nil {
+			return nil, err
+		}
+	}
+	return fw.close()
+}
+
+type shpReader struct {
+	r       io.Reader
+	records int
+}
+
+func (s *shpReader) readHeader() error {
+	header := make([]byte, 100)
+	if _, err := io.ReadFull(s.r, header); err != nil {
+		return fmt.Errorf("cannot read .shp header: %w", err)
+	}
+	if binary.BigEndian.Uint32(header) != 9994 {
+		return fmt.Errorf("invalid .shp file")
+	}
+	return nil
+}
+
+// shape types, Z and M variants add 10 and 20
+const (
+	shapeNull       = 0
+	shapePoint      = 1
+	shapePolyLine   = 3
+	shapePolygon    = 5
+	shapeMultiPoint = 8
+)
+
+// readRecord returns nil geometry for null shapes and io.EOF after the last record
+func (s *shpReader) readRecord() (*geom.Geometry, error) {
+	header := make([]byte, 8)
+	// ReadFull returns io.EOF only when there are no more records
+	if _, err := io.ReadFull(s.r, header); err != nil {
+		return nil, err
+	}
+	s.records++
+	content := make([]byte, int(binary.BigEndian.Uint32(header[4:]))*2)
+	if _, err := io.ReadFull(s.r, content); err != nil {
+		return nil, err
+	}
+	return parseShape(content)
+}
+
+type shapeReader struct {
+	r *bytes.Reader
+}
+
+func (r shapeReader) read(data interface{}) error {
+	return binary.Read(r.r, binary.LittleEndian, data)
+}
+
+func (r shapeReader) readPoints(n int32) ([]geom.Coord, error) {
+	if n < 0 || int(n) > r.r.Len()/16 {
+		return nil, fmt.Errorf("invalid number of points %d", n)
+	}
+	values := make([]float64, n*2)
+	if err := r.read(values); err != nil {
+		return nil, err
+	}
+	points := make([]geom.Coord, n)
+	for i := range points {
+		points[i] = geom.Coord{values[i*2], values[i*2+1]}
+	}
+	return points, nil
+}
+
+// readZ appends z values which follow points of Z shapes
+func (r shapeReader) readZ(points []geom.Coord) error {
+	// skip z range
+	if _, err := r.r.Seek(16, io.SeekCurrent); err != nil {
+		return err
+	}
+	values := make([]float64, len(points))
+	if err := r.read(values); err != nil {
+		return err
+	}
+	for i := range points {
+		points[i] = append(points[i], values[i])
+	}
+	return nil
+}
+
+func parseShape(content []byte) (*geom.Geometry, error) {
+	r := shapeReader{r: bytes.NewReader(content)}
+	var shapeType int32
+	if err := r.read(&shapeType); err != nil {
+		return nil, err
+	}
+	if shapeType == shapeNull {
+		return nil, nil
+	}
+	if shapeType > 28 {
+		// MultiPatch
+		return nil, fmt.Errorf("unsupported shape type %d", shapeType)
+	}
+	hasZ := shapeType > 10 && shapeType < 20
+	switch shapeType % 10 {
+	case shapePoint:
+		points, err := r.readPoints(1)
+		if err != nil {
+			return nil, err
+		}
+		if hasZ {
+			var z float64
+			if err := r.read(&z); err != nil {
+				return nil, err
+			}
+			points[0] = append(points[0], z)
+		}
+		return &geom.Geometry{Type: geom.Point, HasZ: hasZ, Point: points[0]}, nil
+	case shapeMultiPoint:
+		// skip bounding box
+		if _, err := r.r.Seek(32, io.SeekCurrent); err != nil {
+			return nil, err
+		}
+		var n int32
+		if err := r.read(&n); err != nil {
+			return nil, err
+		}
+		points, err := r.readPoints(n)
+		if err != nil {
+			return nil, err
+		}
+		if hasZ {
+			if err := r.readZ(points); err != nil {
+				return nil, err
+			}
+		}
+		return &geom.Geometry{Type: geom.MultiPoint, HasZ: hasZ, Line: points}, nil
+	case shapePolyLine, shapePolygon:
+		if _, err := r.r.Seek(32, io.SeekCurrent); err != nil {
+			return nil, err
+		}
+		var numParts, numPoints int32
+		if err := r.read(&numParts); err != nil {
+			return nil, err
+		}
+		if err := r.read(&numPoints); err != nil {
+			return nil, err
+		}
+		if numParts < 0 || int(numParts) > r.r.Len()/4 {
+			return nil, fmt.Errorf("invalid number of parts %d", numParts)
+		}
+		parts := make([]int32, numParts)
+		if err := r.read(parts); err != nil {
+			return nil, err
+		}
+		points, err := r.readPoints(numPoints)
+		if err != nil {
+			return nil, err
+		}
+		if hasZ {
+			if err := r.readZ(points); err != nil {
+				return nil, err
+			}
+		}
+		rings := make([][]geom.Coord, numParts)
+		for i, start := range parts {
+			end := numPoints
+			if i+1 < len(parts) {
+				end = parts[i+1]
+			}
+			if start < 0 || start > end || end > numPoints {
+				return nil, fmt.Errorf("invalid part %d", i)
+			}
+			rings[i] = points[start:end]
+		}
+		if shapeType%10 == shapePolygon {
+			return polygonFromRings(rings, hasZ), nil
+		}
+		if len(rings) == 1 {
+			return &geom.Geometry{Type: geom.LineString, HasZ: hasZ, Line: rings[0]}, nil
+		}
+		return &geom.Geometry{Type: geom.MultiLineString, HasZ: hasZ, Rings: rings}, nil
+	default:
+		return nil, fmt.Errorf("unsupported shape type %d", shapeType)
+	}
+}
+
+// signedArea is negative for clockwise rings, which are outer rings in shapefiles
+func signedArea(ring []geom.Coord) float64 {
+	area := 0.0
+	for i := 0; i+1 < len(ring); i++ {
+		area += ring[i][0]*ring[i+1][1] - ring[i+1][0]*ring[i][1]
+	}
+	return area / 2
+}
+
+func ringContains(ring []geom.Coord, c geom.Coord) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		if (ring[i][1] > c[1]) != (ring[j][1] > c[1]) &&
+			c[0] < (ring[j][0]-ring[i][0])*(c[1]-ring[i][1])/(ring[j][1]-ring[i][1])+ring[i][0] {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// polygonFromRings groups holes with outer rings which contain them
+func polygonFromRings(rings [][]geom.Coord, hasZ bool) *geom.Geometry {
+	var polygons [][][]geom.Coord
+	var holes [][]geom.Coord
+	for _, ring := range rings {
+		if len(ring) == 0 {
+			continue
+		}
+		if signedArea(ring) <= 0 {
+			polygons = append(polygons, [][]geom.Coord{ring})
+		} else {
+			holes = append(holes, ring)
+		}
+	}
+	for _, hole := range holes {
+		owner := -1
+		for i := len(polygons) - 1; i >= 0; i-- {
+			if ringContains(polygons[i][0], hole[0]) {
+				owner = i
+				break
+			}
+		}
+		if owner < 0 {
+			// counter-clockwise ring outside of any polygon is written by some tools as outer ring
+			polygons = append(polygons, [][]geom.Coord{hole})
+			continue
+		}
+		polygons[owner] = append(polygons[owner], hole)
+	}
+	if len(polygons) == 1 {
+		return &geom.Geometry{Type: geom.Polygon, HasZ: hasZ, Rings: polygons[0]}
+	}
+	return &geom.Geometry{Type: geom.MultiPolygon, HasZ: hasZ, Polygons: polygons}
+}
+
+type dbfField struct {
+	name      string
+	fieldType byte
+	length    int
+}
+
+func (f dbfField) columnType() string {
+	switch f.fieldType {
+	case 'N', 'F':
+		return "number"
+	case 'L':
+		return "boolean"
+	case 'D':
+		return "date"
+	default:
+		return "string"
+	}
+}
+
+type dbfReader struct {
+	r         io.Reader
+	fields    []dbfField
+	recordLen int
+}
+
+func (d *dbfReader) readHeader() error {
+	header := make([]byte, 32)
+	if _, err := io.ReadFull(d.r, header); err != nil {
+		return fmt.Errorf("cannot read .dbf header: %w", err)
+	}
+	headerLen := int(binary.LittleEndian.Uint16(header[8:]))
+	d.recordLen = int(binary.LittleEndian.Uint16(header[10:]))
+	if headerLen < 33 {
+		return fmt.Errorf("invalid .dbf header length %d", headerLen)
+	}
+	descriptors := make([]byte, headerLen-32)
+	if _, err := io.ReadFull(d.r, descriptors); err != nil {
+		return fmt.Errorf("cannot read .dbf fields: %w", err)
+	}
+	length := 1
+	for i := 0; i+32 <= len(descriptors) && descriptors[i] != 0x0D; i += 32 {
+		descriptor := descriptors[i : i+32]
+		name := descriptor[:11]
+		if end := bytes.IndexByte(name, 0); end >= 0 {
+			name = name[:end]
+		}
+		field := dbfField{
+			name:      decodeDBFString(name),
+			fieldType: descriptor[11],
+			length:    int(descriptor[16]),
+		}
+		length += field.length
+		d.fields = append(d.fields, field)
+	}
+	if length > d.recordLen {
+		return fmt.Errorf("invalid .dbf record length %d", d.recordLen)
+	}
+	return nil
+}
+
+// decodeDBFString treats non UTF-8 text as Latin-1, the most common legacy encoding of .dbf
+func decodeDBFString(b []byte) string {
+	if utf8.Valid(b) {
+		return string(b)
+	}
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
+
+func (d *dbfReader) readRecord() (map[string]interface{}, bool, error) {
+	record := make([]byte, d.recordLen)
+	if _, err := io.ReadFull(d.r, record); err != nil {
+		return nil, false, err
+	}
+	if record[0] == '*' {
+		return nil, true, nil
+	}
+	properties := make(map[string]interface{}, len(d.fields))
+	offset := 1
+	for _, field := range d.fields {
+		raw := strings.TrimSpace(decodeDBFString(record[offset : offset+field.length]))
+		offset += field.length
+		properties[field.name] = parseDBFValue(field.fieldType, raw)
+	}
+	return properties, false, nil
+}
+
+func parseDBFValue(fieldType byte, raw string) interface{} {
+	switch fieldType {
+	case 'N', 'F':
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil || math.IsInf(v, 0) || math.IsNaN(v) {
+			return nil
+		}
+		return v
+	case 'L':
+		switch strings.ToUpper(raw) {
+		case "T", "Y":
+			return true
+		case "F", "N":
+			return false
+		default:
+			return nil
+		}
+	case 'D':
+		if len(raw) != 8 {
+			return nil
+		}
+		return raw[:4] + "-" + raw[4:6] + "-" + raw[6:]
+	default:
+		if raw == "" {
+			return nil
+		}
+		return raw
+	}
+}
@@ -0,0 +1,188 @@
+package dekart
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"dekart/src/proto"
+	"dekart/src/server/user"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func hashAPIToken(token string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(token)))
+}
+
+func newAPIToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return user.APITokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// apiTokenLastUsedInterval is precision of token last_used_at; token is resolved on every request,
+// so last_used_at is not written more often
+const apiTokenLastUsedInterval = time.Minute
+
+// ResolveAPIToken implements user.TokenResolver
+func (s Server) ResolveAPIToken(ctx context.Context, token string) (*user.Claims, error) {
+	claims := &user.Claims{}
+	var outdated bool
+	err := s.db.QueryRowContext(ctx,
+		`select id, user_email, last_used_at is null or last_used_at < CURRENT_TIMESTAMP - $2 * interval '1 second'
+		from api_tokens
+		where token_hash=$1 and revoked_at is null`,
+		hashAPIToken(token),
+		apiTokenLastUsedInterval.Seconds(),
+	).Scan(&claims.APITokenID, &claims.Email, &outdated)
+	if err == sql.ErrNoRows {
+		log.Warn().Msg("Unknown or revoked API token")
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if outdated {
+		_, err = s.db.ExecContext(ctx,
+			`update api_tokens set last_used_at=CURRENT_TIMESTAMP where id=$1`,
+			claims.APITokenID,
+		)
+		if err != nil {
+			log.Err(err).Str("api_token_id", claims.APITokenID).Msg("Cannot update API token last use")
+		}
+	}
+	return claims, nil
+}
+
+func rowsToAPITokens(rows *sql.Rows) ([]*proto.ApiToken, error) {
+	apiTokens := make([]*proto.ApiToken, 0)
+	for rows.Next() {
+		apiToken := proto.ApiToken{}
+		var createdAt time.Time
+		var lastUsedAt sql.NullTime
+		err := rows.Scan(
+			&apiToken.Id,
+			&apiToken.Name,
+			&apiToken.Prefix,
+			&createdAt,
+			&lastUsedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		apiToken.CreatedAt = createdAt.Unix()
+		if lastUsedAt.Valid {
+			apiToken.LastUsedAt = lastUsedAt.Time.Unix()
+		}
+		apiTokens = append(apiTokens, &apiToken)
+	}
+	return apiTokens, rows.Err()
+}
+
+// CreateApiToken for current user; token itself is returned only in this response
+func (s Server) CreateApiToken(ctx context.Context, req *proto.CreateApiTokenRequest) (*proto.CreateApiTokenResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	if claims.APITokenID != "" {
+		return nil, status.Error(codes.PermissionDenied, "API tokens cannot be created with API token")
+	}
+	token, err := newAPIToken()
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	apiToken := &proto.ApiToken{
+		Id:        newUUID(),
+		Name:      strings.TrimSpace(req.Name),
+		Prefix:    token[:len(user.APITokenPrefix)+6],
+		CreatedAt: time.Now().Unix(),
+	}
+	_, err = s.db.ExecContext(ctx,
+		`insert into api_tokens (id, user_email, name, token_hash, token_prefix)
+		values ($1, $2, $3, $4, $5)`,
+		apiToken.Id,
+		claims.Email,
+		apiToken.Name,
+		hashAPIToken(token),
+		apiToken.Prefix,
+	)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &proto.CreateApiTokenResponse{
+		ApiToken: apiToken,
+		Token:    token,
+	}, nil
+}
+
+// GetApiTokens of current user which are not revoked
+func (s Server) GetApiTokens(ctx context.Context, req *proto.GetApiTokensRequest) (*proto.GetApiTokensResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	rows, err := s.db.QueryContext(ctx,
+		`select id, name, token_prefix, created_at, last_used_at
+		from api_tokens
+		where user_email=$1 and revoked_at is null
+		order by created_at desc`,
+		claims.Email,
+	)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+	apiTokens, err := rowsToAPITokens(rows)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &proto.GetApiTokensResponse{ApiTokens: apiTokens}, nil
+}
+
+// RevokeApiToken of current user
+func (s Server) RevokeApiToken(ctx context.Context, req *proto.RevokeApiTokenRequest) (*proto.RevokeApiTokenResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	_, err := uuid.Parse(req.ApiTokenId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	result, err := s.db.ExecContext(ctx,
+		`update api_tokens set revoked_at=CURRENT_TIMESTAMP
+		where id=$1 and user_email=$2 and revoked_at is null`,
+		req.ApiTokenId,
+		claims.Email,
+	)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if affectedRows == 0 {
+		err := fmt.Errorf("api token not found id:%s", req.ApiTokenId)
+		log.Warn().Err(err).Send()
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &proto.RevokeApiTokenResponse{}, nil
+}
@@ -0,0 +1,109 @@
+//go:build integration
+
+package dekart
+
+import (
+	"context"
+	"database/sql"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"dekart/src/proto"
+	"dekart/src/server/user"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func lastUsedAt(t *testing.T, db *sql.DB, apiTokenID string) sql.NullTime {
+	var lastUsed sql.NullTime
+	require.NoError(t, db.QueryRow(`select last_used_at from api_tokens where id=$1`, apiTokenID).Scan(&lastUsed))
+	return lastUsed
+}
+
+func TestAPITokens(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	ctx := context.Background()
+
+	created, err := s.CreateApiToken(userContext("user@example.com"), &proto.CreateApiTokenRequest{Name: " ci "})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(created.Token, user.APITokenPrefix))
+	require.True(t, strings.HasPrefix(created.Token, created.ApiToken.Prefix))
+	require.Equal(t, "ci", created.ApiToken.Name)
+
+	// only hash of token is stored
+	var count int
+	require.NoError(t, db.QueryRow(
+		`select count(*) from api_tokens where token_hash=$1 and token_hash<>$2`,
+		hashAPIToken(created.Token), created.Token,
+	).Scan(&count))
+	require.Equal(t, 1, count)
+
+	res, err := s.GetApiTokens(userContext("user@example.com"), &proto.GetApiTokensRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.ApiTokens, 1)
+	require.Equal(t, created.ApiToken.Id, res.ApiTokens[0].Id)
+	res, err = s.GetApiTokens(userContext("other@example.com"), &proto.GetApiTokensRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.ApiTokens)
+
+	claims, err := s.ResolveAPIToken(ctx, created.Token)
+	require.NoError(t, err)
+	require.Equal(t, "user@example.com", claims.Email)
+	require.Equal(t, created.ApiToken.Id, claims.APITokenID)
+	claims, err = s.ResolveAPIToken(ctx, created.Token+"x")
+	require.NoError(t, err)
+	require.Nil(t, claims)
+
+	// tokens cannot be created with token
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("Authorization", "Bearer "+created.Token)
+	tokenCtx := user.NewClaimsCheck("", false, false, "", "").WithTokenResolver(s).GetContext(r)
+	require.Equal(t, created.ApiToken.Id, user.GetClaims(tokenCtx).APITokenID)
+	_, err = s.CreateApiToken(tokenCtx, &proto.CreateApiTokenRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// token of other user cannot be revoked
+	_, err = s.RevokeApiToken(userContext("other@example.com"), &proto.RevokeApiTokenRequest{ApiTokenId: created.ApiToken.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.RevokeApiToken(userContext("user@example.com"), &proto.RevokeApiTokenRequest{ApiTokenId: created.ApiToken.Id})
+	require.NoError(t, err)
+	claims, err = s.ResolveAPIToken(ctx, created.Token)
+	require.NoError(t, err)
+	require.Nil(t, claims)
+	_, err = s.RevokeApiToken(userContext("user@example.com"), &proto.RevokeApiTokenRequest{ApiTokenId: created.ApiToken.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAPITokenLastUsed(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	ctx := context.Background()
+
+	created, err := s.CreateApiToken(userContext("user@example.com"), &proto.CreateApiTokenRequest{})
+	require.NoError(t, err)
+	require.False(t, lastUsedAt(t, db, created.ApiToken.Id).Valid)
+
+	_, err = s.ResolveAPIToken(ctx, created.Token)
+	require.NoError(t, err)
+	require.True(t, lastUsedAt(t, db, created.ApiToken.Id).Valid)
+
+	// recent use is not written again
+	recent := time.Now().Add(-30 * time.Second).Truncate(time.Second)
+	_, err = db.Exec(`update api_tokens set last_used_at=$1 where id=$2`, recent, created.ApiToken.Id)
+	require.NoError(t, err)
+	_, err = s.ResolveAPIToken(ctx, created.Token)
+	require.NoError(t, err)
+	require.True(t, recent.Equal(lastUsedAt(t, db, created.ApiToken.Id).Time))
+
+	outdated := time.Now().Add(-2 * time.Minute)
+	_, err = db.Exec(`update api_tokens set last_used_at=$1 where id=$2`, outdated, created.ApiToken.Id)
+	require.NoError(t, err)
+	_, err = s.ResolveAPIToken(ctx, created.Token)
+	require.NoError(t, err)
+	require.True(t, lastUsedAt(t, db, created.ApiToken.Id).Time.After(recent))
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"dekart/src/proto"
+	"dekart/src/server/job"
+	"dekart/src/server/tracing"
 	"dekart/src/server/user"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -13,11 +16,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (s Server) getDatasets(ctx context.Context, reportID string) ([]*proto.Dataset, error) {
+	ctx, span := tracing.Start(ctx, "Server.getDatasets", attribute.String("report.id", reportID))
+	defer span.End()
 	datasets := make([]*proto.Dataset, 0)
 
 	// add legacy queries
@@ -80,10 +86,11 @@ func (s Server) getDatasets(ctx context.Context, reportID string) ([]*proto.Data
 func (s Server) getReportID(ctx context.Context, datasetID string, email string) (*string, error) {
 	datasetRows, err := s.db.QueryContext(ctx,
 		`select report_id from datasets
-		where id=$1 and report_id in (select report_id from reports where author_email=$2)
+		where id=$1 and report_role(report_id, $2) >= $3
 		limit 1`,
 		datasetID,
 		email,
+		roleEditor,
 	)
 	if err != nil {
 		return nil, err
@@ -100,10 +107,11 @@ func (s Server) getReportID(ctx context.Context, datasetID string, email string)
 		// check legacy queries
 		queryRows, err := s.db.QueryContext(ctx,
 			`select report_id from queries
-			where id=$1 and report_id in (select report_id from reports where author_email=$2)
+			where id=$1 and report_role(report_id, $2) >= $3
 			limit 1`,
 			datasetID,
 			email,
+			roleEditor,
 		)
 		if err != nil {
 			return nil, err
@@ -183,11 +191,12 @@ func (s Server) CreateDataset(ctx context.Context, req *proto.CreateDatasetReque
 			$1 as id,
 			id as report_id
 		from reports
-		where id=$2 and not archived and author_email=$3 limit 1
+		where id=$2 and not archived and report_role(id, $3) >= $4 limit 1
 		`,
 		id,
 		req.ReportId,
 		claims.Email,
+		roleEditor,
 	)
 	if err != nil {
 		log.Err(err).Send()
@@ -201,7 +210,7 @@ func (s Server) CreateDataset(ctx context.Context, req *proto.CreateDatasetReque
 	}
 
 	if affectedRows == 0 {
-		err := fmt.Errorf("report=%s, author_email=%s not found", req.ReportId, claims.Email)
+		err := fmt.Errorf("report=%s, editor=%s not found", req.ReportId, claims.Email)
 		log.Warn().Err(err).Send()
 		return nil, status.Errorf(codes.NotFound, err.Error())
 	}
@@ -212,6 +221,12 @@ func (s Server) CreateDataset(ctx context.Context, req *proto.CreateDatasetReque
 	return res, nil
 }
 
+// datasetSourceContentTypes by extension of dataset source
+var datasetSourceContentTypes = map[string]string{
+	"csv":     "text/csv",
+	"geojson": geoJSONMimeType,
+}
+
 func (s Server) ServeDatasetSource(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	ctx := r.Context()
@@ -229,7 +244,7 @@ func (s Server) ServeDatasetSource(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	defer objectReader.Close()
-	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Type", datasetSourceContentTypes[vars["extension"]])
 	w.Header().Set("Cache-Control", "public, max-age=31536000")
 	w.Header().Set("Last-Modified", ctreated.Format(time.UnixDate))
 	if _, err := io.Copy(w, objectReader); err != nil {
@@ -238,3 +253,55 @@ func (s Server) ServeDatasetSource(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// ServeQueryResultParquet converts CSV result of query job to Parquet for download;
+// result shown only to user who ran query is not served to others
+func (s Server) ServeQueryResultParquet(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	ctx := r.Context()
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	_, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var schemaJSON string
+	err = s.db.QueryRowContext(ctx,
+		`select schema from queries
+		where job_result_id = $1 and not (job_user_scoped and job_user_email <> $2)
+		limit 1`,
+		vars["id"],
+		claims.Email,
+	).Scan(&schemaJSON)
+	if err == sql.ErrNoRows {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Err(err).Send()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	var schema []job.Column
+	if err := json.Unmarshal([]byte(schemaJSON), &schema); err != nil {
+		log.Warn().Err(err).Str("job_result_id", vars["id"]).Msg("Cannot unmarshal result schema")
+		schema = nil
+	}
+	objectReader, err := s.storage.GetObject(fmt.Sprintf("%s.csv", vars["id"])).GetReader(ctx)
+	if err != nil {
+		log.Err(err).Send()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer objectReader.Close()
+	w.Header().Set("Content-Type", parquetMimeType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.parquet\"", vars["id"]))
+	if err := job.ConvertResultToParquet(w, objectReader, schema); err != nil {
+		// headers are sent already, response is incomplete
+		log.Err(err).Str("job_result_id", vars["id"]).Msg("Cannot convert result to Parquet")
+	}
+}
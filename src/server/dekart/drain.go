@@ -0,0 +1,106 @@
+package dekart
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"dekart/src/server/job"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errShuttingDown is returned when job is started after shutdown began
+var errShuttingDown = errors.New("server is shutting down, run query again")
+
+// shuttingDownStatusError is grpc error of RunQuery during shutdown, clients can retry on another instance
+var shuttingDownStatusError = status.Error(codes.Unavailable, errShuttingDown.Error())
+
+const (
+	drainPollInterval = time.Second
+	// releaseTimeout is reserved at the end of shutdown ctx for releasing jobs still running
+	releaseTimeout = 2 * time.Second
+)
+
+// Shutdown stops accepting new jobs and lets running jobs finish until releaseTimeout before ctx deadline;
+// jobs still running then are stopped on this instance and released, other instances resume them when
+// datasource can do it, otherwise they run the query again
+func (s Server) Shutdown(ctx context.Context) {
+	s.setShuttingDown()
+	drainCtx, cancel := context.WithCancel(ctx)
+	if deadline, ok := ctx.Deadline(); ok {
+		drainCtx, cancel = context.WithDeadline(ctx, deadline.Add(-releaseTimeout))
+	}
+	s.waitForJobs(drainCtx)
+	cancel()
+	if s.runningJobs() == 0 {
+		return
+	}
+	// stopped jobs do not update status, so jobs released below are not changed by this instance anymore
+	for _, datasource := range s.datasources.List() {
+		datasource.Store.StopAll()
+	}
+	for _, datasource := range s.datasources.List() {
+		s.releaseJobs(ctx, datasource)
+	}
+}
+
+// runningJobs in all datasources of instance
+func (s Server) runningJobs() int {
+	running := 0
+	for _, datasource := range s.datasources.List() {
+		running += datasource.Store.RunningJobs()
+	}
+	return running
+}
+
+// waitForJobs until all jobs are finished or ctx is done
+func (s Server) waitForJobs(ctx context.Context) {
+	running := s.runningJobs()
+	if running == 0 {
+		return
+	}
+	log.Info().Int("jobs", running).Msg("Waiting for running jobs to finish")
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if s.runningJobs() == 0 {
+				log.Info().Msg("All jobs finished")
+				return
+			}
+		case <-ctx.Done():
+			log.Warn().Int("jobs", s.runningJobs()).Msg("Shutdown grace period is over")
+			return
+		}
+	}
+}
+
+// releaseJobs of datasource from this instance; outdated heartbeat lets another instance, or this one after
+// restart, take them right away. Jobs which cannot be resumed, because datasource cannot re-attach to them
+// or they were not started in datasource yet, are marked to run again
+func (s Server) releaseJobs(ctx context.Context, datasource job.Datasource) {
+	_, resumable := datasource.Store.(job.Resumer)
+	result, err := s.db.ExecContext(ctx,
+		`update jobs set instance_id=NULL, heartbeat_at='-infinity', rerun=(not $3 or external_id='')
+		where instance_id=$1 and datasource_id=$2`,
+		s.instanceID,
+		datasource.ID,
+		resumable,
+	)
+	if err != nil {
+		log.Err(err).Str("datasource", datasource.ID).Msg("Cannot release jobs, they will be recovered after heartbeat timeout")
+		return
+	}
+	released, err := result.RowsAffected()
+	if err != nil {
+		log.Err(err).Send()
+		return
+	}
+	if released > 0 {
+		log.Info().Str("datasource", datasource.ID).Int64("jobs", released).Bool("resumable", resumable).Msg("Jobs released to other instances")
+	}
+}
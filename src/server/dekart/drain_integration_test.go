@@ -0,0 +1,113 @@
+//go:build integration
+
+package dekart
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"dekart/src/proto"
+	"dekart/src/server/job"
+	"dekart/src/server/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+// noResumeStore runs jobs of testStore but cannot resume them
+type noResumeStore struct {
+	store *testStore
+}
+
+func (s noResumeStore) Create(reportID string, queryID string, queryText string) (job.Job, chan int32, error) {
+	return s.store.Create(reportID, queryID, queryText)
+}
+
+func (s noResumeStore) Cancel(queryID string) bool    { return s.store.Cancel(queryID) }
+func (s noResumeStore) CancelAll(ctx context.Context) { s.store.CancelAll(ctx) }
+func (s noResumeStore) StopAll()                      { s.store.StopAll() }
+func (s noResumeStore) RunningJobs() int              { return s.store.RunningJobs() }
+
+// shutdownNow gives running jobs no time to finish, only time to release them
+func shutdownNow(s *Server) {
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout+100*time.Millisecond)
+	defer cancel()
+	s.Shutdown(ctx)
+}
+
+func waitForTrackedJob(t *testing.T, s *Server, queryID string) {
+	waitFor(t, func() bool {
+		return countJobs(t, s.db, "query_id=$1 and instance_id=$2 and external_id<>''", queryID, s.instanceID) == 1
+	})
+}
+
+func TestShutdownReleasesJobs(t *testing.T) {
+	db := newTestDB(t)
+	s, store := newTestServer(t, db)
+	ctx := context.Background()
+
+	reportID, queryID := createTestQuery(t, db, "author@example.com", "select 1")
+	datasource, _ := s.datasources.Get("")
+	require.NoError(t, s.runJob(ctx, reportID, queryID, "select 1", datasource, jobRun{}))
+	waitForTrackedJob(t, s, queryID)
+
+	shutdownNow(s)
+	require.Equal(t, errShuttingDown, s.runJob(ctx, reportID, queryID, "select 1", datasource, jobRun{}))
+
+	// job is stopped locally and left running for other instance
+	waitFor(t, func() bool { return store.RunningJobs() == 0 })
+	require.Equal(t, 1, countJobs(t, db, "query_id=$1 and instance_id is null and heartbeat_at='-infinity' and not rerun", queryID))
+	jobStatus, _ := queryStatus(t, db, queryID)
+	require.Equal(t, int32(proto.Query_JOB_STATUS_RUNNING), jobStatus)
+
+	other, otherStore := newTestServer(t, db)
+	other.recoverJobs(ctx)
+	require.Len(t, otherStore.getResumed(), 1)
+	require.Equal(t, 1, countJobs(t, db, "query_id=$1 and instance_id=$2", queryID, other.instanceID))
+}
+
+func TestShutdownRerunsJobs(t *testing.T) {
+	db := newTestDB(t)
+	bucket, err := storage.NewLocalStorageInDir(t.TempDir())
+	require.NoError(t, err)
+	store := &testStore{}
+	datasources := job.NewDatasources()
+	datasources.Register("test", "TEST", noResumeStore{store}, job.Limits{})
+	s := NewServer(db, bucket, datasources)
+	t.Cleanup(func() { store.CancelAll(context.Background()) })
+	ctx := context.Background()
+
+	reportID, queryID := createTestQuery(t, db, "author@example.com", "select {{n}}")
+	datasource, _ := s.datasources.Get("")
+	run := jobRun{
+		userEmail:  "author@example.com",
+		limits:     job.Limits{Timeout: time.Hour, MaxRows: 10},
+		params:     []job.Parameter{{Name: "n", Type: proto.ReportParameter_TYPE_NUMBER, Value: "1"}},
+		paramsHash: "hash",
+	}
+	require.NoError(t, s.runJob(ctx, reportID, queryID, "select {{n}}", datasource, run))
+	waitForTrackedJob(t, s, queryID)
+	var stoppedJobID string
+	require.NoError(t, db.QueryRow(`select job_id from queries where id=$1`, queryID).Scan(&stoppedJobID))
+
+	shutdownNow(s)
+	waitFor(t, func() bool { return store.RunningJobs() == 0 })
+	require.Equal(t, 1, countJobs(t, db, "id=$1 and instance_id is null and rerun", stoppedJobID))
+
+	// query is run again with options of stopped job
+	other, otherStore := newTestServer(t, db)
+	other.recoverJobs(ctx)
+	require.Empty(t, otherStore.getResumed())
+	require.Equal(t, 0, countJobs(t, db, "id=$1", stoppedJobID))
+	require.Equal(t, 1, countJobs(t, db, "query_id=$1 and instance_id=$2 and user_email=$3", queryID, other.instanceID, "author@example.com"))
+	require.Equal(t, 1, otherStore.RunningJobs())
+	otherStore.Lock()
+	rerun := otherStore.Jobs[0]
+	otherStore.Unlock()
+	require.Equal(t, run.limits, rerun.GetLimits())
+	require.Equal(t, run.params, rerun.GetParameters())
+	var jobID, paramsHash string
+	require.NoError(t, db.QueryRow(`select job_id, job_parameters_hash from queries where id=$1`, queryID).Scan(&jobID, &paramsHash))
+	require.Equal(t, rerun.GetID(), jobID)
+	require.Equal(t, "hash", paramsHash)
+}
@@ -0,0 +1,189 @@
+package dekart
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"dekart/src/proto"
+	"dekart/src/server/job"
+	"dekart/src/server/user"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// estimateLimits refuse queries which are estimated to scan more bytes than allowed
+type estimateLimits struct {
+	global           int64            // 0 means no limit
+	users            map[string]int64 // limits by user email, override global limit
+	allowUnestimated bool             // run queries which cannot be estimated despite limit
+}
+
+// getEstimateLimits reads DEKART_MAX_QUERY_ESTIMATE_BYTES and DEKART_MAX_QUERY_ESTIMATE_BYTES_BY_USER,
+// the latter is a list like alice@example.com:1000000000,bob@example.com:0 where 0 means no limit;
+// DEKART_ALLOW_UNESTIMATED_QUERIES=1 runs queries which datasource cannot estimate
+func getEstimateLimits() estimateLimits {
+	limits := estimateLimits{
+		users:            map[string]int64{},
+		allowUnestimated: os.Getenv("DEKART_ALLOW_UNESTIMATED_QUERIES") == "1",
+	}
+	if value := os.Getenv("DEKART_MAX_QUERY_ESTIMATE_BYTES"); value != "" {
+		global, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || global < 0 {
+			log.Fatal().Str("DEKART_MAX_QUERY_ESTIMATE_BYTES", value).Msg("Cannot parse query estimate limit")
+		}
+		limits.global = global
+	}
+	users, err := parseUserLimits(os.Getenv("DEKART_MAX_QUERY_ESTIMATE_BYTES_BY_USER"))
+	if err != nil {
+		log.Fatal().Err(err).Msg("Cannot parse DEKART_MAX_QUERY_ESTIMATE_BYTES_BY_USER")
+	}
+	limits.users = users
+	return limits
+}
+
+// parseUserLimits parses comma separated email:limit pairs
+func parseUserLimits(value string) (map[string]int64, error) {
+	limits := map[string]int64{}
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		i := strings.LastIndex(pair, ":")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid user limit %s", pair)
+		}
+		limit, err := strconv.ParseInt(strings.TrimSpace(pair[i+1:]), 10, 64)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid user limit %s", pair)
+		}
+		limits[strings.ToLower(strings.TrimSpace(pair[:i]))] = limit
+	}
+	return limits, nil
+}
+
+// limit of estimated bytes for user, empty email means global limit
+func (l estimateLimits) limit(email string) int64 {
+	if limit, ok := l.users[strings.ToLower(email)]; ok {
+		return limit
+	}
+	return l.global
+}
+
+// estimateCost in units of DEKART_QUERY_COST_PER_TB, for example USD
+func estimateCost(datasource job.Datasource, bytes int64) float64 {
+	value := job.Config{ID: datasource.ID}.Getenv("DEKART_QUERY_COST_PER_TB")
+	if value == "" {
+		return 0
+	}
+	costPerTB, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Warn().Err(err).Str("DEKART_QUERY_COST_PER_TB", value).Msg("Cannot parse query cost")
+		return 0
+	}
+	return float64(bytes) / (1 << 40) * costPerTB
+}
+
+// checkQueryEstimate returns error when query estimate exceeds limit of the user;
+// queries which datasource cannot estimate are refused unless allowUnestimated is set
+func (s Server) checkQueryEstimate(ctx context.Context, email string, datasource job.Datasource, queryText string, params []job.Parameter) error {
+	limit := s.estimateLimits.limit(email)
+	if limit == 0 {
+		return nil
+	}
+	estimator, ok := datasource.Store.(job.Estimator)
+	if !ok {
+		if s.estimateLimits.allowUnestimated {
+			return nil
+		}
+		return fmt.Errorf("datasource %s cannot estimate query, limit is %d bytes", datasource.ID, limit)
+	}
+	estimate, err := estimator.Estimate(ctx, queryText, params)
+	if err != nil {
+		log.Warn().Err(err).Str("datasource", datasource.ID).Msg("Cannot estimate query")
+		if s.estimateLimits.allowUnestimated {
+			return nil
+		}
+		return fmt.Errorf("cannot estimate query, limit is %d bytes: %w", limit, err)
+	}
+	if estimate.Bytes > limit {
+		return fmt.Errorf("query is estimated to scan %d bytes, limit is %d bytes", estimate.Bytes, limit)
+	}
+	return nil
+}
+
+// EstimateQuery returns bytes query is expected to scan without running it
+func (s Server) EstimateQuery(ctx context.Context, req *proto.EstimateQueryRequest) (*proto.EstimateQueryResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	var reportID string
+	var datasourceID string
+	err := s.db.QueryRowContext(ctx,
+		`select
+			reports.id,
+			queries.datasource_id
+		from queries
+			left join datasets on queries.id = datasets.query_id
+			left join reports on (datasets.report_id = reports.id or queries.report_id = reports.id)
+		where queries.id = $1 and report_role(reports.id, $2) >= $3
+		limit 1`,
+		req.QueryId,
+		claims.Email,
+		roleEditor,
+	).Scan(&reportID, &datasourceID)
+	if err == sql.ErrNoRows {
+		err := fmt.Errorf("query not found id:%s", req.QueryId)
+		log.Warn().Err(err).Send()
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if req.DatasourceId != "" {
+		datasourceID = req.DatasourceId
+	}
+	datasource, ok := s.datasources.Get(datasourceID)
+	if !ok {
+		err := fmt.Errorf("datasource %s is not configured", datasourceID)
+		log.Warn().Err(err).Send()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	estimator, ok := datasource.Store.(job.Estimator)
+	if !ok {
+		err := fmt.Errorf("datasource %s does not support query estimates", datasource.ID)
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+	queryText := req.QueryText
+	if queryText == "" {
+		queryText, err = s.getQueryText(ctx, req.QueryId)
+		if err != nil {
+			log.Err(err).Send()
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	params, _, err := s.resolveParameters(ctx, reportID, queryText, req.Parameters, claims.Email)
+	if err != nil {
+		return nil, parametersStatusError(err)
+	}
+	estimate, err := estimator.Estimate(ctx, queryText, params)
+	if err != nil {
+		log.Warn().Err(err).Str("query_id", req.QueryId).Msg("Cannot estimate query")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	limit := s.estimateLimits.limit(claims.Email)
+	return &proto.EstimateQueryResponse{
+		EstimatedBytes: estimate.Bytes,
+		EstimatedCost:  estimateCost(datasource, estimate.Bytes),
+		LimitBytes:     limit,
+		ExceedsLimit:   limit > 0 && estimate.Bytes > limit,
+	}, nil
+}
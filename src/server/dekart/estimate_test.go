@@ -0,0 +1,76 @@
+package dekart
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"dekart/src/server/job"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUserLimits(t *testing.T) {
+	limits, err := parseUserLimits(" Alice@Example.com:1000, bob@example.com:0,,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{"alice@example.com": 1000, "bob@example.com": 0}, limits)
+
+	limits, err = parseUserLimits("")
+	require.NoError(t, err)
+	require.Empty(t, limits)
+
+	for _, value := range []string{"alice@example.com", ":1000", "alice@example.com:-1", "alice@example.com:1e3"} {
+		_, err = parseUserLimits(value)
+		require.Error(t, err, value)
+	}
+
+	l := estimateLimits{global: 100, users: limits}
+	require.Equal(t, int64(100), l.limit("carol@example.com"))
+}
+
+// plainStore cannot estimate queries
+type plainStore struct {
+	job.BasicStore
+}
+
+func (s *plainStore) Create(reportID string, queryID string, queryText string) (job.Job, chan int32, error) {
+	return nil, nil, errors.New("not implemented")
+}
+
+type estimateStore struct {
+	plainStore
+	bytes int64
+	err   error
+}
+
+func (s *estimateStore) Estimate(ctx context.Context, queryText string, params []job.Parameter) (*job.Estimate, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &job.Estimate{Bytes: s.bytes}, nil
+}
+
+func TestCheckQueryEstimate(t *testing.T) {
+	ctx := context.Background()
+	s := Server{estimateLimits: estimateLimits{
+		global: 100,
+		users:  map[string]int64{"admin@example.com": 0},
+	}}
+	check := func(email string, store job.Store) error {
+		return s.checkQueryEstimate(ctx, email, job.Datasource{ID: "test", Store: store}, "select 1", nil)
+	}
+
+	require.NoError(t, check("user@example.com", &estimateStore{bytes: 100}))
+	require.Error(t, check("user@example.com", &estimateStore{bytes: 101}))
+	require.NoError(t, check("admin@example.com", &estimateStore{bytes: 101}))
+
+	// queries which cannot be estimated are refused by default
+	require.Error(t, check("user@example.com", &plainStore{}))
+	require.Error(t, check("user@example.com", &estimateStore{err: errors.New("dry run failed")}))
+	require.NoError(t, check("admin@example.com", &plainStore{}))
+
+	s.estimateLimits.allowUnestimated = true
+	require.NoError(t, check("user@example.com", &plainStore{}))
+	require.NoError(t, check("user@example.com", &estimateStore{err: errors.New("dry run failed")}))
+	require.Error(t, check("user@example.com", &estimateStore{bytes: 101}))
+}
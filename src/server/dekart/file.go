@@ -4,12 +4,17 @@ import (
 	"context"
 	"database/sql"
 	"dekart/src/proto"
+	"dekart/src/server/convert"
+	"dekart/src/server/metrics"
 	"dekart/src/server/user"
+	"encoding/json"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -26,9 +31,10 @@ func (s Server) getFileReports(ctx context.Context, fileId string, claims *user.
 		from files
 			left join datasets on files.id = datasets.file_id
 			left join reports on datasets.report_id = reports.id
-		where files.id = $1 and author_email = $2`,
+		where files.id = $1 and report_role(reports.id, $2) >= $3`,
 		fileId,
 		claims.Email,
+		roleEditor,
 	)
 	if err != nil {
 		return nil, err
@@ -61,36 +67,146 @@ func (s Server) setUploadError(reportIDs []string, fileSourceID string, err erro
 	s.reportStreams.PingAll(reportIDs)
 }
 
-func getFileExtension(mimeType string) string {
-	switch mimeType {
-	case "text/csv":
+// parquetMimeType is stored for parquet uploads, browsers usually send empty or octet-stream type
+const parquetMimeType = "application/vnd.apache.parquet"
+
+// geoJSONMimeType is stored for geo formats converted to GeoJSON, so client loads them as GeoJSON
+const geoJSONMimeType = "application/geo+json"
+
+// uploadMimeTypes maps mime types of supported uploads to file extensions
+var uploadMimeTypes = map[string]string{
+	"text/csv":                             "csv",
+	geoJSONMimeType:                        "geojson",
+	parquetMimeType:                        "parquet",
+	"application/x-parquet":                "parquet",
+	"application/zip":                      "zip",
+	"application/x-zip-compressed":         "zip",
+	"application/vnd.google-earth.kml+xml": "kml",
+	"application/vnd.google-earth.kmz":     "kmz",
+	"application/geopackage+sqlite3":       "gpkg",
+}
+
+// getFileExtension of uploaded file, file name is used when browser does not know the format
+func getFileExtension(mimeType string, fileName string) string {
+	if ext, ok := uploadMimeTypes[mimeType]; ok {
+		return ext
+	}
+	ext := strings.TrimPrefix(strings.ToLower(filepath.Ext(fileName)), ".")
+	for _, supported := range uploadMimeTypes {
+		if ext == supported {
+			return ext
+		}
+	}
+	return ""
+}
+
+// getStoredMimeType of file after conversion, it is known even if browser sent generic type
+func getStoredMimeType(fileExtension string) string {
+	switch fileExtension {
+	case "csv":
+		return "text/csv"
+	case "parquet":
+		return parquetMimeType
+	default:
+		return geoJSONMimeType
+	}
+}
+
+// getStoredExtension of storage object, converted files are stored as CSV or GeoJSON
+func getStoredExtension(fileExtension string) string {
+	switch fileExtension {
+	case "parquet":
 		return "csv"
-	case "application/geo+json":
+	case "zip", "kml", "kmz", "gpkg":
 		return "geojson"
 	default:
-		return ""
+		return fileExtension
 	}
 }
 
-func (s Server) moveFileToStorage(fileSourceID string, fileExtension string, file multipart.File, reportIDs []string) {
-	defer file.Close()
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+// convertFile writes converted file to w; zip is expected to contain shapefile
+func convertFile(ctx context.Context, fileExtension string, file multipart.File, size int64, w io.Writer) (*convert.Result, error) {
+	switch fileExtension {
+	case "parquet":
+		return convert.ParquetToCSV(ctx, file, w)
+	case "zip":
+		return convert.ShapefileToGeoJSON(ctx, file, size, w)
+	case "kml":
+		return convert.KMLToGeoJSON(ctx, file, w)
+	case "kmz":
+		return convert.KMZToGeoJSON(ctx, file, size, w)
+	case "gpkg":
+		return convert.GeoPackageToGeoJSON(ctx, file, w)
+	default:
+		return nil, fmt.Errorf("conversion of %s is not supported", fileExtension)
+	}
+}
+
+// convertFileToStorage writes converted file to storage and saves its row count and schema
+func (s Server) convertFileToStorage(ctx context.Context, fileSourceID string, fileExtension string, file multipart.File, size int64) error {
+	// writers do not create object when context is canceled, so failed conversion leaves no partial object
+	writeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	storageWriter := s.storage.GetObject(fmt.Sprintf("%s.%s", fileSourceID, getStoredExtension(fileExtension))).GetWriter(writeCtx)
+	result, err := convertFile(ctx, fileExtension, file, size, storageWriter)
+	if err != nil {
+		cancel()
+		storageWriter.Close()
+		return fmt.Errorf("cannot convert %s file: %w", fileExtension, err)
+	}
+	err = storageWriter.Close()
+	if err != nil {
+		return err
+	}
+	schema, err := json.Marshal(result.Schema)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx,
+		`update files set row_count=$1, schema=$2 where file_source_id=$3`,
+		result.RowCount,
+		string(schema),
+		fileSourceID,
+	)
+	return err
+}
+
+func (s Server) copyFileToStorage(ctx context.Context, fileSourceID string, fileExtension string, file multipart.File) error {
+	writeCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
-	storageWriter := s.storage.GetObject(fmt.Sprintf("%s.%s", fileSourceID, fileExtension)).GetWriter(ctx)
+	storageWriter := s.storage.GetObject(fmt.Sprintf("%s.%s", fileSourceID, fileExtension)).GetWriter(writeCtx)
 	_, err := io.Copy(storageWriter, file)
 	if err != nil {
-		log.Err(err).Send()
-		s.setUploadError(reportIDs, fileSourceID, err)
-		return
+		cancel()
+		storageWriter.Close()
+		return err
 	}
+	return storageWriter.Close()
+}
 
-	err = storageWriter.Close()
+func (s Server) moveFileToStorage(fileSourceID string, fileExtension string, file multipart.File, size int64, reportIDs []string) {
+	defer file.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+	var err error
+	start := time.Now()
+	defer func() { metrics.ObserveUpload("file", start, err) }()
+	if getStoredExtension(fileExtension) != fileExtension {
+		err = s.convertFileToStorage(ctx, fileSourceID, fileExtension, file, size)
+	} else {
+		err = s.copyFileToStorage(ctx, fileSourceID, fileExtension, file)
+	}
 	if err != nil {
 		log.Err(err).Send()
 		s.setUploadError(reportIDs, fileSourceID, err)
+		return
 	}
-	log.Debug().Msgf("file %s.csv moved to storage", fileSourceID)
-	_, err = s.db.ExecContext(ctx,
+	log.Debug().Msgf("file %s.%s moved to storage", fileSourceID, getStoredExtension(fileExtension))
+	s.setFileStored(ctx, fileSourceID, reportIDs)
+}
+
+func (s Server) setFileStored(ctx context.Context, fileSourceID string, reportIDs []string) {
+	_, err := s.db.ExecContext(ctx,
 		`update files set file_status=3 where file_source_id=$1`,
 		fileSourceID,
 	)
@@ -102,31 +218,44 @@ func (s Server) moveFileToStorage(fileSourceID string, fileExtension string, fil
 	s.reportStreams.PingAll(reportIDs)
 }
 
-func (s Server) UploadFile(w http.ResponseWriter, r *http.Request) {
+// getUploadFileReports checks that upload is enabled and user can write file reports, writes error response otherwise
+func (s Server) getUploadFileReports(w http.ResponseWriter, r *http.Request) ([]string, bool) {
 	if len(os.Getenv("DEKART_ALLOW_FILE_UPLOAD")) == 0 {
 		log.Warn().Msg("file upload is disabled, set DEKART_ALLOW_FILE_UPLOAD to enable")
 		w.WriteHeader(http.StatusForbidden)
-		return
+		return nil, false
 	}
 	fileId := mux.Vars(r)["id"]
 	ctx := r.Context()
 	claims := user.GetClaims(ctx)
 	if claims == nil {
 		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return nil, false
 	}
 	reportIds, err := s.getFileReports(ctx, fileId, claims)
 
 	if err != nil {
 		log.Err(err).Send()
 		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return nil, false
 	}
 
 	if len(reportIds) == 0 {
 		err = fmt.Errorf("file not found or permission not granted")
 		log.Err(err).Send()
 		http.Error(w, err.Error(), http.StatusNotFound)
+		return nil, false
+	}
+	return reportIds, true
+}
+
+func (s Server) UploadFile(w http.ResponseWriter, r *http.Request) {
+	reportIds, ok := s.getUploadFileReports(w, r)
+	if !ok {
 		return
 	}
+	fileId := mux.Vars(r)["id"]
+	ctx := r.Context()
 
 	file, handler, err := r.FormFile("file")
 	if err != nil {
@@ -136,7 +265,7 @@ func (s Server) UploadFile(w http.ResponseWriter, r *http.Request) {
 	}
 	mimeType := handler.Header.Get("Content-Type")
 
-	fileExtension := getFileExtension(mimeType)
+	fileExtension := getFileExtension(mimeType, handler.Filename)
 
 	if fileExtension == "" {
 		err = fmt.Errorf("unsupported file type")
@@ -144,6 +273,7 @@ func (s Server) UploadFile(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	mimeType = getStoredMimeType(fileExtension)
 	fileSourceID := newUUID()
 
 	_, err = s.db.ExecContext(ctx,
@@ -160,7 +290,7 @@ func (s Server) UploadFile(w http.ResponseWriter, r *http.Request) {
 		file.Close()
 		return
 	}
-	go s.moveFileToStorage(fileSourceID, fileExtension, file, reportIds)
+	go s.moveFileToStorage(fileSourceID, fileExtension, file, handler.Size, reportIds)
 	s.reportStreams.PingAll(reportIds)
 
 }
@@ -239,6 +369,11 @@ func (s Server) getFiles(ctx context.Context, datasets []*proto.Dataset) ([]*pro
 				file_status,
 				file_source_id,
 				upload_error,
+				row_count,
+				schema,
+				chunk_size,
+				chunk_count,
+				uploaded_chunks,
 				created_at,
 				updated_at
 			from files where id = ANY($1) order by created_at asc`,
@@ -253,6 +388,8 @@ func (s Server) getFiles(ctx context.Context, datasets []*proto.Dataset) ([]*pro
 			file := proto.File{}
 
 			var sourceId sql.NullString
+			var schema []byte
+			var uploadedChunks pq.Int64Array
 			var createdAt time.Time
 			var updatedAt time.Time
 
@@ -264,6 +401,11 @@ func (s Server) getFiles(ctx context.Context, datasets []*proto.Dataset) ([]*pro
 				&file.FileStatus,
 				&sourceId,
 				&file.UploadError,
+				&file.RowCount,
+				&schema,
+				&file.ChunkSize,
+				&file.ChunkCount,
+				&uploadedChunks,
 				&createdAt,
 				&updatedAt,
 			); err != nil {
@@ -271,6 +413,13 @@ func (s Server) getFiles(ctx context.Context, datasets []*proto.Dataset) ([]*pro
 				return nil, err
 			}
 			file.SourceId = sourceId.String
+			for _, chunk := range uploadedChunks {
+				file.UploadedChunks = append(file.UploadedChunks, int32(chunk))
+			}
+			if err = json.Unmarshal(schema, &file.Schema); err != nil {
+				log.Error().Err(err).Msg("parse file schema failed")
+				return nil, err
+			}
 			file.CreatedAt = createdAt.Unix()
 			file.UpdatedAt = updatedAt.Unix()
 			files = append(files, &file)
@@ -4,6 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"dekart/src/proto"
+	"dekart/src/server/tracing"
+	"dekart/src/server/user"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +15,17 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+// claimsEmail of user in ctx, empty when ctx has no claims
+func claimsEmail(ctx context.Context) string {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return ""
+	}
+	return claims.Email
+}
+
+// rowsToQueries scans queries; result of query which depends on user is not shown to other users,
+// query looks as not run for them
 func rowsToQueries(queryRows *sql.Rows) ([]*proto.Query, error) {
 	queries := make([]*proto.Query, 0)
 	for queryRows.Next() {
@@ -19,6 +33,8 @@ func rowsToQueries(queryRows *sql.Rows) ([]*proto.Query, error) {
 		query := proto.Query{}
 		var createdAt time.Time
 		var updatedAt time.Time
+		var schema []byte
+		var hidden bool
 		if err := queryRows.Scan(
 			&query.Id,
 			&queryText,
@@ -33,9 +49,28 @@ func rowsToQueries(queryRows *sql.Rows) ([]*proto.Query, error) {
 			&updatedAt,
 			&query.QuerySource,
 			&query.QuerySourceId,
+			&query.DatasourceId,
+			&schema,
+			&query.JobTruncation,
+			&hidden,
 		); err != nil {
 			log.Fatal().Err(err).Send()
 		}
+		if hidden {
+			query.JobStatus = proto.Query_JOB_STATUS_UNSPECIFIED
+			query.JobResultId = ""
+			query.JobError = ""
+			query.TotalRows = 0
+			query.BytesProcessed = 0
+			query.ResultSize = 0
+			query.JobTruncation = ""
+			schema = nil
+		}
+		if len(schema) > 0 {
+			if err := json.Unmarshal(schema, &query.Schema); err != nil {
+				log.Err(err).Str("queryID", query.Id).Msg("Cannot parse query result schema")
+			}
+		}
 
 		switch query.QuerySource {
 		case proto.Query_QUERY_SOURCE_UNSPECIFIED:
@@ -61,6 +96,8 @@ func rowsToQueries(queryRows *sql.Rows) ([]*proto.Query, error) {
 }
 
 func (s Server) getQueries(ctx context.Context, datasets []*proto.Dataset) ([]*proto.Query, error) {
+	ctx, span := tracing.Start(ctx, "Server.getQueries")
+	defer span.End()
 	queryIds := make([]string, 0)
 	for _, dataset := range datasets {
 		if dataset.QueryId != "" {
@@ -87,9 +124,14 @@ func (s Server) getQueries(ctx context.Context, datasets []*proto.Dataset) ([]*p
 				created_at,
 				updated_at,
 				query_source,
-				query_source_id
+				query_source_id,
+				datasource_id,
+				schema,
+				job_truncation,
+				job_user_scoped and job_user_email <> $2 as hidden
 			from queries where id = ANY($1) order by created_at asc`,
 			pq.Array(queryIds),
+			claimsEmail(ctx),
 		)
 		if err != nil {
 			log.Fatal().Err(err).Msgf("select from queries failed, ids: %s", queryIdsStr)
@@ -120,9 +162,14 @@ func (s Server) getQueriesLegacy(ctx context.Context, reportID string) ([]*proto
 			created_at,
 			updated_at,
 			query_source,
-			query_source_id
+			query_source_id,
+			datasource_id,
+			schema,
+			job_truncation,
+			job_user_scoped and job_user_email <> $2 as hidden
 		from queries where report_id=$1 order by created_at asc`,
 		reportID,
+		claimsEmail(ctx),
 	)
 	if err != nil {
 		log.Err(err).Str("reportID", reportID).Msg("select from queries failed")
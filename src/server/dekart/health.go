@@ -0,0 +1,131 @@
+package dekart
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"dekart/src/server/job"
+
+	"github.com/rs/zerolog/log"
+)
+
+// healthTimeout of all checks of health request
+const healthTimeout = 5 * time.Second
+
+// health state shared by copies of Server
+type health struct {
+	migrationVersion uint  // latest migration, set on start
+	shuttingDown     int32 // set to 1 when shutdown started
+}
+
+// healthCheck result reported by /healthz and /readyz
+type healthCheck struct {
+	Name   string `json:"name"`
+	OK     bool   `json:"ok"`
+	Detail string `json:"detail,omitempty"`
+}
+
+func newHealthCheck(name string, err error, detail string) healthCheck {
+	if err != nil {
+		return healthCheck{Name: name, Detail: err.Error()}
+	}
+	return healthCheck{Name: name, OK: true, Detail: detail}
+}
+
+// SetMigrationVersion of latest migration; health check fails while metadata database schema is older
+func (s Server) SetMigrationVersion(version uint) {
+	s.health.migrationVersion = version
+}
+
+func (s Server) setShuttingDown() {
+	atomic.StoreInt32(&s.health.shuttingDown, 1)
+}
+
+func (s Server) isShuttingDown() bool {
+	return atomic.LoadInt32(&s.health.shuttingDown) == 1
+}
+
+// checkMigrations fails when migration failed or database schema is older than server
+func (s Server) checkMigrations(ctx context.Context) (string, error) {
+	var version uint
+	var dirty bool
+	err := s.db.QueryRowContext(ctx, `select version, dirty from schema_migrations limit 1`).Scan(&version, &dirty)
+	if err != nil {
+		return "", err
+	}
+	if dirty {
+		return "", fmt.Errorf("migration %d failed", version)
+	}
+	if version < s.health.migrationVersion {
+		return "", fmt.Errorf("schema version %d, expected %d", version, s.health.migrationVersion)
+	}
+	return fmt.Sprintf("schema version %d", version), nil
+}
+
+// checkDatasource pings datasource when store supports it and reports running jobs
+func checkDatasource(ctx context.Context, datasource job.Datasource) healthCheck {
+	var err error
+	if pinger, ok := datasource.Store.(job.Pinger); ok {
+		err = pinger.Ping(ctx)
+	}
+	detail := fmt.Sprintf("%s, %d jobs running", datasource.Type, datasource.Store.RunningJobs())
+	return newHealthCheck("datasource:"+datasource.ID, err, detail)
+}
+
+// checkDependencies of metadata database, its schema, storage and datasources
+func (s Server) checkDependencies(ctx context.Context) []healthCheck {
+	ctx, cancel := context.WithTimeout(ctx, healthTimeout)
+	defer cancel()
+	checks := make([]healthCheck, 0)
+	checks = append(checks, newHealthCheck("database", s.db.PingContext(ctx), ""))
+	detail, err := s.checkMigrations(ctx)
+	checks = append(checks, newHealthCheck("migrations", err, detail))
+	checks = append(checks, newHealthCheck("storage", s.storage.Ping(ctx), ""))
+	for _, datasource := range s.datasources.List() {
+		checks = append(checks, checkDatasource(ctx, datasource))
+	}
+	return checks
+}
+
+// writeHealth responds with checks, status is 503 when any check failed
+func writeHealth(w http.ResponseWriter, checks []healthCheck) {
+	ok := true
+	for _, check := range checks {
+		if !check.OK {
+			ok = false
+			log.Warn().Str("check", check.Name).Str("detail", check.Detail).Msg("Health check failed")
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	err := json.NewEncoder(w).Encode(struct {
+		OK     bool          `json:"ok"`
+		Checks []healthCheck `json:"checks"`
+	}{ok, checks})
+	if err != nil {
+		log.Err(err).Send()
+	}
+}
+
+// ServeHealth reports that process serves requests; dependencies are not checked,
+// so outage of database or datasource does not restart healthy instances
+func (s Server) ServeHealth(w http.ResponseWriter, r *http.Request) {
+	writeHealth(w, []healthCheck{newHealthCheck("process", nil, "")})
+}
+
+// ServeReadiness reports health of dependencies and fails while server is shutting down
+func (s Server) ServeReadiness(w http.ResponseWriter, r *http.Request) {
+	var err error
+	if s.isShuttingDown() {
+		err = fmt.Errorf("server is shutting down")
+	}
+	checks := append(s.checkDependencies(r.Context()), newHealthCheck("shutdown", err, ""))
+	writeHealth(w, checks)
+}
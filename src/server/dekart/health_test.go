@@ -0,0 +1,17 @@
+package dekart
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeHealth(t *testing.T) {
+	// dependencies are not checked, server without database is healthy
+	w := httptest.NewRecorder()
+	Server{}.ServeHealth(w, httptest.NewRequest("GET", "/healthz", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"ok":true,"checks":[{"name":"process","ok":true}]}`, w.Body.String())
+}
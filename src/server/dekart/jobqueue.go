@@ -0,0 +1,438 @@
+package dekart
+
+import (
+	"context"
+	"database/sql"
+	"dekart/src/proto"
+	"dekart/src/server/job"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// running jobs are tracked in jobs table; instance keeps heartbeat of its jobs
+// and jobs with outdated heartbeat are resumed or failed by any instance
+const (
+	jobHeartbeatInterval = 10 * time.Second
+	jobHeartbeatTimeout  = 30 * time.Second
+	jobRecoveryInterval  = 30 * time.Second
+)
+
+type trackedJob struct {
+	id           string
+	queryID      string
+	reportID     string
+	datasourceID string
+	externalID   string
+	userEmail    string
+	// cancelRequested by user on instance which does not run the job
+	cancelRequested bool
+	// rerun is set when job was released on shutdown and cannot be resumed, query is run again with runOptions
+	rerun      bool
+	runOptions string
+}
+
+// runOptions of job stored with tracked job, they are used when job is run again
+type runOptions struct {
+	Limits     job.Limits
+	Parameters []job.Parameter
+}
+
+// limits of original run; jobs tracked before run options were stored get datasource limits
+func (t trackedJob) limits(datasource job.Datasource) job.Limits {
+	var options runOptions
+	if err := json.Unmarshal([]byte(t.runOptions), &options); err != nil {
+		return datasource.Limits
+	}
+	return options.Limits
+}
+
+// marshalRunOptions of job to JSON stored in jobs.run_options
+func marshalRunOptions(job job.Job) string {
+	b, err := json.Marshal(runOptions{
+		Limits:     job.GetLimits(),
+		Parameters: job.GetParameters(),
+	})
+	if err != nil {
+		log.Err(err).Msg("Cannot marshal job run options")
+		return ""
+	}
+	return string(b)
+}
+
+// trackJob persists job so it can be recovered when instance stops; tracked jobs are counted in quotas
+func (s Server) trackJob(ctx context.Context, db execer, job job.Job, datasourceID string, userEmail string) error {
+	_, err := db.ExecContext(ctx,
+		`insert into jobs (id, query_id, report_id, datasource_id, job_status, instance_id, user_email, run_options)
+		values ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		job.GetID(),
+		job.GetQueryID(),
+		job.GetReportID(),
+		datasourceID,
+		int32(proto.Query_JOB_STATUS_PENDING),
+		s.instanceID,
+		userEmail,
+		marshalRunOptions(job),
+	)
+	return err
+}
+
+// updateTrackedJob stores job status and external id; finished jobs are removed
+// and bytes they processed are appended to job_usage of the user
+func (s Server) updateTrackedJob(ctx context.Context, job job.Job, status int32) error {
+	var err error
+	switch status {
+	case int32(proto.Query_JOB_STATUS_DONE), int32(proto.Query_JOB_STATUS_UNSPECIFIED):
+		_, err = s.db.ExecContext(ctx,
+			`with finished as (delete from jobs where id=$1 returning user_email)
+			insert into job_usage (job_id, user_email, bytes_processed)
+			select $1, user_email, $2 from finished where user_email <> ''
+			on conflict (job_id) do nothing`,
+			job.GetID(),
+			job.GetProcessedBytes(),
+		)
+	default:
+		_, err = s.db.ExecContext(ctx,
+			`update jobs set
+				job_status=$2,
+				external_id=$3,
+				updated_at=CURRENT_TIMESTAMP
+			where id=$1`,
+			job.GetID(),
+			status,
+			job.GetExternalID(),
+		)
+	}
+	return err
+}
+
+// ResumeJobs keeps heartbeat of instance jobs and recovers jobs left by stopped instances until ctx is done
+func (s Server) ResumeJobs(ctx context.Context) {
+	heartbeat := time.NewTicker(jobHeartbeatInterval)
+	defer heartbeat.Stop()
+	recovery := time.NewTicker(jobRecoveryInterval)
+	defer recovery.Stop()
+	s.reconcileQueries(ctx)
+	s.recoverJobs(ctx)
+	for {
+		select {
+		case <-heartbeat.C:
+			err := s.heartbeat(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Err(err).Msg("Cannot update jobs heartbeat")
+			}
+		case <-recovery.C:
+			s.recoverJobs(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// heartbeat of instance jobs; jobs cancelled by user on another instance are cancelled here
+func (s Server) heartbeat(ctx context.Context) error {
+	rows, err := s.db.QueryContext(ctx,
+		`update jobs set heartbeat_at=CURRENT_TIMESTAMP where instance_id=$1
+		returning id, query_id, report_id, cancel_requested`,
+		s.instanceID,
+	)
+	if err != nil {
+		return err
+	}
+	cancelled := make([]trackedJob, 0)
+	for rows.Next() {
+		var t trackedJob
+		if err := rows.Scan(&t.id, &t.queryID, &t.reportID, &t.cancelRequested); err != nil {
+			rows.Close()
+			return err
+		}
+		if t.cancelRequested {
+			cancelled = append(cancelled, t)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, t := range cancelled {
+		log.Debug().Str("jobID", t.id).Str("queryID", t.queryID).Msg("Cancel requested from another instance")
+		if s.datasources.Cancel(t.queryID) {
+			continue
+		}
+		// job is not running anymore, only tracking is left
+		s.cancelTrackedJob(ctx, t)
+	}
+	return nil
+}
+
+// cancelTrackedJob marks query of job which is not running on any instance as cancelled and stops tracking it
+func (s Server) cancelTrackedJob(ctx context.Context, t trackedJob) {
+	_, err := s.db.ExecContext(ctx,
+		`update queries set job_status = $1 where id = $2 and (job_id = $3 or job_id is null)`,
+		int32(proto.Query_JOB_STATUS_UNSPECIFIED),
+		t.queryID,
+		t.id,
+	)
+	if err != nil {
+		log.Err(err).Str("queryID", t.queryID).Msg("Cannot mark query as cancelled")
+		return
+	}
+	if _, err := s.db.ExecContext(ctx, `delete from jobs where id=$1`, t.id); err != nil {
+		log.Err(err).Str("jobID", t.id).Msg("Cannot remove cancelled job")
+	}
+	s.reportStreams.Ping(t.reportID)
+}
+
+// reconcileQueries fails queries left running without tracked job, e.g. by server stopped before jobs were tracked
+func (s Server) reconcileQueries(ctx context.Context) {
+	rows, err := s.db.QueryContext(ctx,
+		`update queries set
+			job_status = $1,
+			job_error = $2
+		where job_status in ($3, $4, $5)
+			and coalesce(job_started, created_at) < CURRENT_TIMESTAMP - $6 * interval '1 second'
+			and not exists (select 1 from jobs where jobs.query_id = queries.id)
+		returning coalesce(report_id, (select datasets.report_id from datasets where datasets.query_id = queries.id limit 1))`,
+		int32(proto.Query_JOB_STATUS_UNSPECIFIED),
+		"Job was interrupted by server restart, run query again",
+		int32(proto.Query_JOB_STATUS_PENDING),
+		int32(proto.Query_JOB_STATUS_RUNNING),
+		int32(proto.Query_JOB_STATUS_READING_RESULTS),
+		jobHeartbeatTimeout.Seconds(),
+	)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Err(err).Msg("Cannot reconcile running queries")
+		}
+		return
+	}
+	defer rows.Close()
+	reconciled := 0
+	for rows.Next() {
+		var reportID sql.NullString
+		if err := rows.Scan(&reportID); err != nil {
+			log.Err(err).Send()
+			return
+		}
+		reconciled++
+		if reportID.Valid {
+			s.reportStreams.Ping(reportID.String)
+		}
+	}
+	if reconciled > 0 {
+		log.Warn().Int("queries", reconciled).Msg("Queries left running without job marked as failed")
+	}
+}
+
+// claimJobs assigns jobs with outdated heartbeat to this instance
+func (s Server) claimJobs(ctx context.Context) ([]trackedJob, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`update jobs set instance_id=$1, heartbeat_at=CURRENT_TIMESTAMP
+		where id in (
+			select id from jobs
+			where heartbeat_at < CURRENT_TIMESTAMP - $2 * interval '1 second'
+			for update skip locked
+		)
+		returning id, query_id, report_id, datasource_id, external_id, user_email, cancel_requested, rerun, run_options`,
+		s.instanceID,
+		jobHeartbeatTimeout.Seconds(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	jobs := make([]trackedJob, 0)
+	for rows.Next() {
+		var t trackedJob
+		if err := rows.Scan(&t.id, &t.queryID, &t.reportID, &t.datasourceID, &t.externalID, &t.userEmail, &t.cancelRequested, &t.rerun, &t.runOptions); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, t)
+	}
+	return jobs, rows.Err()
+}
+
+func (s Server) recoverJobs(ctx context.Context) {
+	if s.isShuttingDown() {
+		// jobs released on shutdown are resumed by other instances
+		return
+	}
+	jobs, err := s.claimJobs(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Err(err).Msg("Cannot claim jobs for recovery")
+		}
+		return
+	}
+	for _, t := range jobs {
+		logger := log.With().Str("jobID", t.id).Str("queryID", t.queryID).Str("externalID", t.externalID).Logger()
+		if t.cancelRequested {
+			logger.Info().Msg("Job cancelled by user is not resumed")
+			s.cancelTrackedJob(ctx, t)
+			continue
+		}
+		if t.rerun {
+			err := s.rerunJob(ctx, t)
+			if err != nil {
+				logger.Warn().Err(err).Msg("Cannot run job again")
+				s.failJob(ctx, t, err)
+			} else {
+				logger.Info().Msg("Job run again")
+			}
+			continue
+		}
+		err := s.resumeJob(ctx, t)
+		if err != nil {
+			logger.Warn().Err(err).Msg("Cannot resume job")
+			s.failJob(ctx, t, err)
+		} else {
+			logger.Info().Msg("Job resumed")
+		}
+		if _, err := s.db.ExecContext(ctx, `delete from jobs where id=$1`, t.id); err != nil {
+			logger.Err(err).Msg("Cannot remove recovered job")
+		}
+	}
+}
+
+func (s Server) resumeJob(ctx context.Context, t trackedJob) error {
+	if t.externalID == "" {
+		return fmt.Errorf("job was not started in datasource")
+	}
+	datasource, ok := s.datasources.Get(t.datasourceID)
+	if !ok {
+		return fmt.Errorf("datasource %s is not configured", t.datasourceID)
+	}
+	resumer, ok := datasource.Store.(job.Resumer)
+	if !ok {
+		return fmt.Errorf("%s datasource cannot resume jobs", datasource.Type)
+	}
+	queryText, err := s.getQueryText(ctx, t.queryID)
+	if err != nil {
+		return err
+	}
+	job, jobStatus, err := resumer.Resume(t.reportID, t.queryID, queryText, t.externalID)
+	if err != nil {
+		return err
+	}
+	// timeout of resumed job starts again
+	job.SetLimits(t.limits(datasource))
+	job.StartSpan(ctx)
+	// resumed job replaces tracked job unless query was run again meanwhile
+	result, err := s.db.ExecContext(ctx,
+		`update queries set job_id=$1 where id=$2 and (job_id=$3 or job_id is null)`,
+		job.GetID(),
+		t.queryID,
+		t.id,
+	)
+	if err != nil {
+		job.Cancel()
+		return err
+	}
+	replaced, err := result.RowsAffected()
+	if err != nil {
+		job.Cancel()
+		return err
+	}
+	if replaced == 0 {
+		// query was run again, result of tracked job is not needed
+		job.Cancel()
+		return nil
+	}
+	// resumed job was counted in quotas when it started
+	err = s.trackJob(ctx, s.db, job, datasource.ID, t.userEmail)
+	if err != nil {
+		job.Cancel()
+		return err
+	}
+	obj := s.storage.GetObject(fmt.Sprintf("%s.csv", job.GetID()))
+	go s.updateJobStatus(job, jobStatus, datasource.ID)
+	return job.Run(obj)
+}
+
+// rerunJob starts query of job released on shutdown again, with limits and parameters of original run;
+// tracked job is removed first so it is not counted in quotas of the new job
+func (s Server) rerunJob(ctx context.Context, t trackedJob) error {
+	if _, err := s.db.ExecContext(ctx, `delete from jobs where id=$1`, t.id); err != nil {
+		return err
+	}
+	datasource, ok := s.datasources.Get(t.datasourceID)
+	if !ok {
+		return fmt.Errorf("datasource %s is not configured", t.datasourceID)
+	}
+	var jobID sql.NullString
+	var paramsHash string
+	err := s.db.QueryRowContext(ctx,
+		`select job_id, job_parameters_hash from queries where id=$1`,
+		t.queryID,
+	).Scan(&jobID, &paramsHash)
+	if err != nil {
+		return err
+	}
+	if jobID.Valid && jobID.String != t.id {
+		// query was run again, result of tracked job is not needed
+		return nil
+	}
+	var options runOptions
+	if err := json.Unmarshal([]byte(t.runOptions), &options); err != nil {
+		return fmt.Errorf("cannot read run options: %w", err)
+	}
+	queryText, err := s.getQueryText(ctx, t.queryID)
+	if err != nil {
+		return err
+	}
+	return s.runJob(ctx, t.reportID, t.queryID, queryText, datasource, jobRun{
+		userEmail:  t.userEmail,
+		limits:     options.Limits,
+		params:     options.Parameters,
+		paramsHash: paramsHash,
+	})
+}
+
+// failJob marks query of job which cannot be resumed as failed
+func (s Server) failJob(ctx context.Context, t trackedJob, err error) {
+	_, err = s.db.ExecContext(ctx,
+		`update queries set
+			job_status = $1,
+			job_error = $2
+		where id = $3 and (job_id = $4 or job_id is null)`,
+		int32(proto.Query_JOB_STATUS_UNSPECIFIED),
+		fmt.Sprintf("Job was interrupted by server restart and cannot be resumed (%s), run query again", err),
+		t.queryID,
+		t.id,
+	)
+	if err != nil {
+		log.Err(err).Str("queryID", t.queryID).Msg("Cannot mark query as failed")
+		return
+	}
+	s.reportStreams.Ping(t.reportID)
+}
+
+// getQueryText reads current query text from storage or inline
+func (s Server) getQueryText(ctx context.Context, queryID string) (string, error) {
+	var querySource proto.Query_QuerySource
+	var querySourceID string
+	var queryText string
+	err := s.db.QueryRowContext(ctx,
+		`select query_source, query_source_id, query_text from queries where id=$1`,
+		queryID,
+	).Scan(&querySource, &querySourceID, &queryText)
+	if err != nil {
+		return "", err
+	}
+	if querySource != proto.Query_QUERY_SOURCE_STORAGE {
+		return queryText, nil
+	}
+	reader, err := s.storage.GetObject(fmt.Sprintf("%s.sql", querySourceID)).GetReader(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	queryTextByte, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return string(queryTextByte), nil
+}
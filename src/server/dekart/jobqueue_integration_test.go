@@ -0,0 +1,197 @@
+//go:build integration
+
+package dekart
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"dekart/src/proto"
+
+	"github.com/stretchr/testify/require"
+)
+
+// insertTrackedJob left by another instance with outdated heartbeat when stale
+func insertTrackedJob(t *testing.T, db *sql.DB, reportID string, queryID string, externalID string, stale bool, cancelRequested bool) string {
+	jobID := newUUID()
+	heartbeat := "CURRENT_TIMESTAMP"
+	if stale {
+		heartbeat = "CURRENT_TIMESTAMP - interval '1 hour'"
+	}
+	_, err := db.Exec(
+		`insert into jobs (id, query_id, report_id, datasource_id, job_status, external_id, instance_id, heartbeat_at, cancel_requested)
+		values ($1, $2, $3, 'test', $4, $5, $6, `+heartbeat+`, $7)`,
+		jobID, queryID, reportID, int32(proto.Query_JOB_STATUS_RUNNING), externalID, newUUID(), cancelRequested,
+	)
+	require.NoError(t, err)
+	_, err = db.Exec(
+		`update queries set job_status=$1, job_started=CURRENT_TIMESTAMP, job_id=$2 where id=$3`,
+		int32(proto.Query_JOB_STATUS_RUNNING), jobID, queryID,
+	)
+	require.NoError(t, err)
+	return jobID
+}
+
+func countJobs(t *testing.T, db *sql.DB, where string, args ...interface{}) int {
+	var count int
+	require.NoError(t, db.QueryRow(`select count(*) from jobs where `+where, args...).Scan(&count))
+	return count
+}
+
+func TestClaimJobs(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	ctx := context.Background()
+
+	reportID, staleQueryID := createTestQuery(t, db, "author@example.com", "select 1")
+	staleID := insertTrackedJob(t, db, reportID, staleQueryID, "stale", true, false)
+	_, aliveQueryID := createTestQuery(t, db, "author@example.com", "select 2")
+	insertTrackedJob(t, db, reportID, aliveQueryID, "alive", false, false)
+
+	claimed, err := s.claimJobs(ctx)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.Equal(t, staleID, claimed[0].id)
+	require.Equal(t, "stale", claimed[0].externalID)
+	require.Equal(t, 1, countJobs(t, db, "instance_id=$1", s.instanceID))
+
+	// claimed job has fresh heartbeat and is not claimed again
+	other, _ := newTestServer(t, db)
+	claimed, err = other.claimJobs(ctx)
+	require.NoError(t, err)
+	require.Empty(t, claimed)
+}
+
+func TestRecoverJobs(t *testing.T) {
+	db := newTestDB(t)
+	s, store := newTestServer(t, db)
+	ctx := context.Background()
+
+	reportID, resumedQueryID := createTestQuery(t, db, "author@example.com", "select 1")
+	resumedID := insertTrackedJob(t, db, reportID, resumedQueryID, "resumed", true, false)
+	_, notStartedQueryID := createTestQuery(t, db, "author@example.com", "select 2")
+	insertTrackedJob(t, db, reportID, notStartedQueryID, "", true, false)
+	_, cancelledQueryID := createTestQuery(t, db, "author@example.com", "select 3")
+	insertTrackedJob(t, db, reportID, cancelledQueryID, "cancelled", true, true)
+	// query was run again after job was tracked
+	_, rerunQueryID := createTestQuery(t, db, "author@example.com", "select 4")
+	insertTrackedJob(t, db, reportID, rerunQueryID, "rerun", true, false)
+	rerunJobID := newUUID()
+	_, err := db.Exec(`update queries set job_id=$1 where id=$2`, rerunJobID, rerunQueryID)
+	require.NoError(t, err)
+
+	s.recoverJobs(ctx)
+
+	require.ElementsMatch(t, []string{"resumed", "rerun"}, store.getResumed())
+	waitFor(t, func() bool { return store.RunningJobs() == 1 })
+	require.Equal(t, 0, countJobs(t, db, "id=$1", resumedID))
+	require.Equal(t, 1, countJobs(t, db, "query_id=$1 and instance_id=$2", resumedQueryID, s.instanceID))
+
+	jobStatus, jobError := queryStatus(t, db, notStartedQueryID)
+	require.Equal(t, int32(proto.Query_JOB_STATUS_UNSPECIFIED), jobStatus)
+	require.Contains(t, jobError, "cannot be resumed")
+	require.Equal(t, 0, countJobs(t, db, "query_id=$1", notStartedQueryID))
+
+	jobStatus, jobError = queryStatus(t, db, cancelledQueryID)
+	require.Equal(t, int32(proto.Query_JOB_STATUS_UNSPECIFIED), jobStatus)
+	require.Empty(t, jobError)
+	require.Equal(t, 0, countJobs(t, db, "query_id=$1", cancelledQueryID))
+
+	var jobID string
+	require.NoError(t, db.QueryRow(`select job_id from queries where id=$1`, rerunQueryID).Scan(&jobID))
+	require.Equal(t, rerunJobID, jobID)
+	require.Equal(t, 0, countJobs(t, db, "query_id=$1", rerunQueryID))
+}
+
+func TestHeartbeat(t *testing.T) {
+	db := newTestDB(t)
+	s, store := newTestServer(t, db)
+	ctx := context.Background()
+
+	reportID, queryID := createTestQuery(t, db, "author@example.com", "select 1")
+	datasource, _ := s.datasources.Get("")
+	require.NoError(t, s.runJob(ctx, reportID, queryID, "select 1", datasource, jobRun{}))
+	waitFor(t, func() bool {
+		jobStatus, _ := queryStatus(t, db, queryID)
+		return jobStatus == int32(proto.Query_JOB_STATUS_RUNNING)
+	})
+
+	_, err := db.Exec(`update jobs set heartbeat_at=CURRENT_TIMESTAMP - interval '1 hour'`)
+	require.NoError(t, err)
+	require.NoError(t, s.heartbeat(ctx))
+	require.Equal(t, 1, countJobs(t, db, "instance_id=$1 and heartbeat_at > CURRENT_TIMESTAMP - interval '1 minute'", s.instanceID))
+	require.Equal(t, 1, store.RunningJobs())
+}
+
+func TestCancelQueryOnAnotherInstance(t *testing.T) {
+	db := newTestDB(t)
+	owner, store := newTestServer(t, db)
+	other, _ := newTestServer(t, db)
+	ctx := context.Background()
+
+	reportID, queryID := createTestQuery(t, db, "author@example.com", "select 1")
+	datasource, _ := owner.datasources.Get("")
+	require.NoError(t, owner.runJob(ctx, reportID, queryID, "select 1", datasource, jobRun{}))
+	waitFor(t, func() bool {
+		jobStatus, _ := queryStatus(t, db, queryID)
+		return jobStatus == int32(proto.Query_JOB_STATUS_RUNNING)
+	})
+
+	_, err := other.CancelQuery(userContext("author@example.com"), &proto.CancelQueryRequest{QueryId: queryID})
+	require.NoError(t, err)
+	require.Equal(t, 1, countJobs(t, db, "query_id=$1 and cancel_requested", queryID))
+
+	require.NoError(t, owner.heartbeat(ctx))
+	waitFor(t, func() bool { return store.RunningJobs() == 0 })
+	waitFor(t, func() bool { return countJobs(t, db, "query_id=$1", queryID) == 0 })
+	jobStatus, _ := queryStatus(t, db, queryID)
+	require.Equal(t, int32(proto.Query_JOB_STATUS_UNSPECIFIED), jobStatus)
+}
+
+func TestReconcileQueries(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	ctx := context.Background()
+
+	// query left running before jobs were tracked
+	_, legacyQueryID := createTestQuery(t, db, "author@example.com", "select 1")
+	_, err := db.Exec(
+		`update queries set job_status=$1, job_started=CURRENT_TIMESTAMP - interval '1 hour' where id=$2`,
+		int32(proto.Query_JOB_STATUS_RUNNING), legacyQueryID,
+	)
+	require.NoError(t, err)
+	// query with tracked job
+	reportID, trackedQueryID := createTestQuery(t, db, "author@example.com", "select 2")
+	insertTrackedJob(t, db, reportID, trackedQueryID, "tracked", false, false)
+	// query of dataset, report is linked through dataset only
+	datasetReportID, _ := createTestQuery(t, db, "author@example.com", "select 3")
+	datasetQueryID := newUUID()
+	_, err = db.Exec(
+		`insert into queries (id, query_text, job_status, job_started) values ($1, 'select 4', $2, CURRENT_TIMESTAMP - interval '1 hour')`,
+		datasetQueryID, int32(proto.Query_JOB_STATUS_RUNNING),
+	)
+	require.NoError(t, err)
+	_, err = db.Exec(`insert into datasets (id, report_id, query_id) values ($1, $2, $3)`, newUUID(), datasetReportID, datasetQueryID)
+	require.NoError(t, err)
+	ping := s.reportStreams.Register(datasetReportID, "test", 1)
+	defer s.reportStreams.Deregister(datasetReportID, "test")
+
+	s.reconcileQueries(ctx)
+
+	select {
+	case <-ping:
+	case <-time.After(5 * time.Second):
+		t.Fatal("report of dataset query was not pinged")
+	}
+	jobStatus, jobError := queryStatus(t, db, datasetQueryID)
+	require.Equal(t, int32(proto.Query_JOB_STATUS_UNSPECIFIED), jobStatus)
+	require.Contains(t, jobError, "interrupted")
+
+	jobStatus, jobError = queryStatus(t, db, legacyQueryID)
+	require.Equal(t, int32(proto.Query_JOB_STATUS_UNSPECIFIED), jobStatus)
+	require.Contains(t, jobError, "interrupted")
+	jobStatus, _ = queryStatus(t, db, trackedQueryID)
+	require.Equal(t, int32(proto.Query_JOB_STATUS_RUNNING), jobStatus)
+}
@@ -0,0 +1,189 @@
+package dekart
+
+import (
+	"context"
+	"crypto/sha1"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"dekart/src/proto"
+	"dekart/src/server/job"
+	"dekart/src/server/user"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getReportParameters returns parameter definitions of report ordered by name
+func (s Server) getReportParameters(ctx context.Context, reportID string) ([]*proto.ReportParameter, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`select name, parameter_type, default_value
+		from report_parameters
+		where report_id=$1
+		order by name`,
+		reportID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	parameters := make([]*proto.ReportParameter, 0)
+	for rows.Next() {
+		parameter := proto.ReportParameter{}
+		err := rows.Scan(&parameter.Name, &parameter.Type, &parameter.DefaultValue)
+		if err != nil {
+			return nil, err
+		}
+		parameters = append(parameters, &parameter)
+	}
+	return parameters, rows.Err()
+}
+
+// SetReportParameters replaces parameter definitions of report, available to author and editors
+func (s Server) SetReportParameters(ctx context.Context, req *proto.SetReportParametersRequest) (*proto.SetReportParametersResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	names := make(map[string]bool)
+	for _, parameter := range req.Parameters {
+		if !job.ParameterNameRe.MatchString(parameter.Name) {
+			err := fmt.Errorf("invalid parameter name %q", parameter.Name)
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if parameter.Name == job.UserEmailParameter {
+			err := fmt.Errorf("parameter %s is built-in", parameter.Name)
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if names[parameter.Name] {
+			err := fmt.Errorf("duplicate parameter %s", parameter.Name)
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		names[parameter.Name] = true
+		p := job.Parameter{Name: parameter.Name, Type: parameter.Type, Value: parameter.DefaultValue}
+		if err := p.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if err := s.checkCanWriteReport(ctx, req.ReportId, claims.Email); err != nil {
+		return nil, err
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rollback(tx)
+	_, err = tx.ExecContext(ctx, `delete from report_parameters where report_id=$1`, req.ReportId)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, parameter := range req.Parameters {
+		_, err = tx.ExecContext(ctx,
+			`insert into report_parameters (report_id, name, parameter_type, default_value)
+			values ($1, $2, $3, $4)`,
+			req.ReportId,
+			parameter.Name,
+			parameter.Type,
+			parameter.DefaultValue,
+		)
+		if err != nil {
+			log.Err(err).Send()
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.reportStreams.Ping(req.ReportId)
+	return &proto.SetReportParametersResponse{}, nil
+}
+
+// copyReportParameters of forked report
+func copyReportParameters(ctx context.Context, tx *sql.Tx, fromReportID string, toReportID string) error {
+	_, err := tx.ExecContext(ctx,
+		`insert into report_parameters (report_id, name, parameter_type, default_value)
+		select $1, name, parameter_type, default_value
+		from report_parameters where report_id=$2`,
+		toReportID,
+		fromReportID,
+	)
+	return err
+}
+
+// resolveParameters referenced in query text with values chosen by user or default values;
+// email is value of built-in user_email parameter. Returned hash identifies values, email included,
+// for query cache
+func (s Server) resolveParameters(ctx context.Context, reportID string, queryText string, values map[string]string, email string) ([]job.Parameter, string, error) {
+	names := job.ParameterNames(queryText)
+	if len(names) == 0 {
+		return nil, "", nil
+	}
+	definitions, err := s.getReportParameters(ctx, reportID)
+	if err != nil {
+		return nil, "", err
+	}
+	byName := make(map[string]*proto.ReportParameter, len(definitions))
+	for _, definition := range definitions {
+		byName[definition.Name] = definition
+	}
+	params := make([]job.Parameter, 0, len(names))
+	for _, name := range names {
+		if name == job.UserEmailParameter {
+			params = append(params, job.Parameter{Name: name, Type: proto.ReportParameter_TYPE_STRING, Value: email})
+			continue
+		}
+		definition, ok := byName[name]
+		if !ok {
+			return nil, "", &invalidParameter{fmt.Errorf("parameter %s is not defined in report", name)}
+		}
+		p := job.Parameter{Name: name, Type: definition.Type, Value: definition.DefaultValue}
+		if value, ok := values[name]; ok {
+			p.Value = value
+		}
+		if err := p.Validate(); err != nil {
+			return nil, "", &invalidParameter{err}
+		}
+		params = append(params, p)
+	}
+	return params, parametersHash(params), nil
+}
+
+// userScoped is true when params include user_email, result of such query is shown only to user who ran it
+func userScoped(params []job.Parameter) bool {
+	for _, p := range params {
+		if p.Name == job.UserEmailParameter {
+			return true
+		}
+	}
+	return false
+}
+
+// invalidParameter is error of parameter value provided by user
+type invalidParameter struct {
+	err error
+}
+
+func (e *invalidParameter) Error() string {
+	return e.err.Error()
+}
+
+// parametersStatusError returns grpc error of resolveParameters
+func parametersStatusError(err error) error {
+	if _, ok := err.(*invalidParameter); ok {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Err(err).Send()
+	return status.Error(codes.Internal, err.Error())
+}
+
+// parametersHash of values; parameters are in order of reference so the same query text gives the same order
+func parametersHash(params []job.Parameter) string {
+	b, _ := json.Marshal(params)
+	return fmt.Sprintf("%x", sha1.Sum(b))
+}
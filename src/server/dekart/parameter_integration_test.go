@@ -0,0 +1,74 @@
+//go:build integration
+
+package dekart
+
+import (
+	"context"
+	"testing"
+
+	"dekart/src/proto"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResolveParameters(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	ctx := context.Background()
+	reportID, _ := createTestQuery(t, db, "author@example.com", "select 1")
+	_, err := s.SetReportParameters(userContext("author@example.com"), &proto.SetReportParametersRequest{
+		ReportId: reportID,
+		Parameters: []*proto.ReportParameter{
+			{Name: "min", Type: proto.ReportParameter_TYPE_NUMBER, DefaultValue: "1"},
+		},
+	})
+	require.NoError(t, err)
+
+	params, hash, err := s.resolveParameters(ctx, reportID, "select * from t where n > {{min}} and s = '{{other}}'", nil, "user@example.com")
+	require.NoError(t, err)
+	require.Len(t, params, 1)
+	require.Equal(t, "1", params[0].Value)
+	_, otherHash, err := s.resolveParameters(ctx, reportID, "select * from t where n > {{min}}", map[string]string{"min": "2"}, "user@example.com")
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash)
+
+	// email of user is part of hash, so cached result of one user is not used for another
+	params, hash, err = s.resolveParameters(ctx, reportID, "select * from t where email = {{user_email}}", nil, "user@example.com")
+	require.NoError(t, err)
+	require.Equal(t, "user@example.com", params[0].Value)
+	require.True(t, userScoped(params))
+	_, otherHash, err = s.resolveParameters(ctx, reportID, "select * from t where email = {{user_email}}", nil, "other@example.com")
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash)
+
+	_, err = s.SetReportParameters(userContext("author@example.com"), &proto.SetReportParametersRequest{
+		ReportId:   reportID,
+		Parameters: []*proto.ReportParameter{{Name: "user_email", Type: proto.ReportParameter_TYPE_STRING}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUserScopedResult(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	reportID, queryID := createTestQuery(t, db, "author@example.com", "select {{user_email}}")
+	_, err := db.Exec(
+		`update queries set job_status=$1, job_result_id=$2, total_rows=5, job_user_email=$3, job_user_scoped=true where id=$4`,
+		int32(proto.Query_JOB_STATUS_DONE), newUUID(), "viewer@example.com", queryID,
+	)
+	require.NoError(t, err)
+
+	queries, err := s.getQueriesLegacy(userContext("viewer@example.com"), reportID)
+	require.NoError(t, err)
+	require.NotEmpty(t, queries[0].JobResultId)
+	require.Equal(t, int64(5), queries[0].TotalRows)
+
+	// result filtered for viewer is not shown to author
+	queries, err = s.getQueriesLegacy(userContext("author@example.com"), reportID)
+	require.NoError(t, err)
+	require.Empty(t, queries[0].JobResultId)
+	require.Zero(t, queries[0].TotalRows)
+	require.Equal(t, proto.Query_JOB_STATUS_UNSPECIFIED, queries[0].JobStatus)
+}
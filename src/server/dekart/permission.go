@@ -0,0 +1,174 @@
+package dekart
+
+import (
+	"context"
+	"database/sql"
+	"dekart/src/proto"
+	"dekart/src/server/user"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// roles returned by report_role SQL function; author role is not stored in report_permissions
+const (
+	roleEditor = int32(proto.ReportPermission_ROLE_EDITOR)
+	roleAuthor = roleEditor + 1
+)
+
+var granteeRe = regexp.MustCompile(`^([^@\s]+)?@[^@\s]+\.[^@\s]+$`)
+
+// getReportRole returns role of user in report
+func (s Server) getReportRole(ctx context.Context, reportID string, email string) (int32, error) {
+	var role int32
+	err := s.db.QueryRowContext(ctx,
+		`select report_role($1, $2)`,
+		reportID,
+		email,
+	).Scan(&role)
+	return role, err
+}
+
+// checkReportRole returns grpc error when report does not exist or user role is lower than required
+func (s Server) checkReportRole(ctx context.Context, reportID string, email string, required int32) error {
+	_, err := uuid.Parse(reportID)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	role, err := s.getReportRole(ctx, reportID, email)
+	if err != nil {
+		log.Err(err).Send()
+		return status.Error(codes.Internal, err.Error())
+	}
+	if role < required {
+		err := fmt.Errorf("report not found id:%s", reportID)
+		log.Warn().Err(err).Send()
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return nil
+}
+
+// checkCanWriteReport returns grpc error when user is not author or editor of report
+func (s Server) checkCanWriteReport(ctx context.Context, reportID string, email string) error {
+	return s.checkReportRole(ctx, reportID, email, roleEditor)
+}
+
+// GetReportPermissions lists grants of report, available to author and editors
+func (s Server) GetReportPermissions(ctx context.Context, req *proto.GetReportPermissionsRequest) (*proto.GetReportPermissionsResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	if err := s.checkCanWriteReport(ctx, req.ReportId, claims.Email); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(ctx,
+		`select report_id, grantee, role, granted_by, created_at
+		from report_permissions
+		where report_id=$1
+		order by grantee`,
+		req.ReportId,
+	)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+	permissions := make([]*proto.ReportPermission, 0)
+	for rows.Next() {
+		permission := proto.ReportPermission{}
+		var grantedBy sql.NullString
+		var createdAt time.Time
+		err := rows.Scan(
+			&permission.ReportId,
+			&permission.Grantee,
+			&permission.Role,
+			&grantedBy,
+			&createdAt,
+		)
+		if err != nil {
+			log.Err(err).Send()
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		permission.GrantedBy = grantedBy.String
+		permission.CreatedAt = createdAt.Unix()
+		permissions = append(permissions, &permission)
+	}
+	if err := rows.Err(); err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &proto.GetReportPermissionsResponse{Permissions: permissions}, nil
+}
+
+// SetReportPermission grants role to email or @domain, ROLE_UNSPECIFIED revokes grant; only author can share report
+func (s Server) SetReportPermission(ctx context.Context, req *proto.SetReportPermissionRequest) (*proto.SetReportPermissionResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	grantee := strings.ToLower(strings.TrimSpace(req.Grantee))
+	if !granteeRe.MatchString(grantee) {
+		err := fmt.Errorf("grantee %s is not email or @domain", req.Grantee)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.checkReportRole(ctx, req.ReportId, claims.Email, roleAuthor); err != nil {
+		return nil, err
+	}
+	var err error
+	switch req.Role {
+	case proto.ReportPermission_ROLE_UNSPECIFIED:
+		_, err = s.db.ExecContext(ctx,
+			`delete from report_permissions where report_id=$1 and grantee=$2`,
+			req.ReportId,
+			grantee,
+		)
+	case proto.ReportPermission_ROLE_VIEWER, proto.ReportPermission_ROLE_EDITOR:
+		_, err = s.db.ExecContext(ctx,
+			`insert into report_permissions (report_id, grantee, role, granted_by)
+			values ($1, $2, $3, $4)
+			on conflict (report_id, grantee) do update set role=$3, granted_by=$4`,
+			req.ReportId,
+			grantee,
+			req.Role,
+			claims.Email,
+		)
+	default:
+		err := fmt.Errorf("unknown role %d", req.Role)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.reportStreams.Ping(req.ReportId)
+	return &proto.SetReportPermissionResponse{}, nil
+}
+
+// SetLinkSharing allows anyone with link to read report; only author can share report
+func (s Server) SetLinkSharing(ctx context.Context, req *proto.SetLinkSharingRequest) (*proto.SetLinkSharingResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	if err := s.checkReportRole(ctx, req.ReportId, claims.Email, roleAuthor); err != nil {
+		return nil, err
+	}
+	_, err := s.db.ExecContext(ctx,
+		`update reports set link_sharing=$1 where id=$2`,
+		req.LinkSharing,
+		req.ReportId,
+	)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.reportStreams.Ping(req.ReportId)
+	return &proto.SetLinkSharingResponse{}, nil
+}
@@ -0,0 +1,129 @@
+//go:build integration
+
+package dekart
+
+import (
+	"context"
+	"testing"
+
+	"dekart/src/proto"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setTestPermission(t *testing.T, s *Server, reportID string, grantee string, role proto.ReportPermission_Role) {
+	_, err := s.SetReportPermission(userContext("author@example.com"), &proto.SetReportPermissionRequest{
+		ReportId: reportID,
+		Grantee:  grantee,
+		Role:     role,
+	})
+	require.NoError(t, err)
+}
+
+func TestReportRole(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	ctx := context.Background()
+	reportID, _ := createTestQuery(t, db, "author@example.com", "select 1")
+
+	setTestPermission(t, s, reportID, "Viewer@Example.com", proto.ReportPermission_ROLE_VIEWER)
+	setTestPermission(t, s, reportID, "@partner.org", proto.ReportPermission_ROLE_VIEWER)
+	setTestPermission(t, s, reportID, "lead@partner.org", proto.ReportPermission_ROLE_EDITOR)
+
+	for email, expected := range map[string]int32{
+		"author@example.com":   roleAuthor,
+		"viewer@example.com":   int32(proto.ReportPermission_ROLE_VIEWER),
+		"VIEWER@example.com":   int32(proto.ReportPermission_ROLE_VIEWER),
+		"anyone@partner.org":   int32(proto.ReportPermission_ROLE_VIEWER),
+		"lead@partner.org":     roleEditor,
+		"other@example.com":    0,
+		"anyone@partner.org.x": 0,
+	} {
+		role, err := s.getReportRole(ctx, reportID, email)
+		require.NoError(t, err)
+		require.Equal(t, expected, role, email)
+	}
+
+	// revoked grant
+	setTestPermission(t, s, reportID, "viewer@example.com", proto.ReportPermission_ROLE_UNSPECIFIED)
+	role, err := s.getReportRole(ctx, reportID, "viewer@example.com")
+	require.NoError(t, err)
+	require.Zero(t, role)
+}
+
+func TestSetReportPermission(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	reportID, _ := createTestQuery(t, db, "author@example.com", "select 1")
+	setTestPermission(t, s, reportID, "editor@example.com", proto.ReportPermission_ROLE_EDITOR)
+
+	// only author shares report
+	_, err := s.SetReportPermission(userContext("editor@example.com"), &proto.SetReportPermissionRequest{
+		ReportId: reportID,
+		Grantee:  "other@example.com",
+		Role:     proto.ReportPermission_ROLE_EDITOR,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	for _, grantee := range []string{"", "example.com", "user@", "a b@example.com", "@@example.com"} {
+		_, err = s.SetReportPermission(userContext("author@example.com"), &proto.SetReportPermissionRequest{
+			ReportId: reportID,
+			Grantee:  grantee,
+			Role:     proto.ReportPermission_ROLE_VIEWER,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), grantee)
+	}
+	_, err = s.SetReportPermission(userContext("author@example.com"), &proto.SetReportPermissionRequest{
+		ReportId: reportID,
+		Grantee:  "other@example.com",
+		Role:     proto.ReportPermission_Role(10),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// role of existing grant is updated
+	setTestPermission(t, s, reportID, "editor@example.com", proto.ReportPermission_ROLE_VIEWER)
+	res, err := s.GetReportPermissions(userContext("author@example.com"), &proto.GetReportPermissionsRequest{ReportId: reportID})
+	require.NoError(t, err)
+	require.Len(t, res.Permissions, 1)
+	require.Equal(t, "editor@example.com", res.Permissions[0].Grantee)
+	require.Equal(t, proto.ReportPermission_ROLE_VIEWER, res.Permissions[0].Role)
+	require.Equal(t, "author@example.com", res.Permissions[0].GrantedBy)
+
+	// permissions are listed to editors only
+	_, err = s.GetReportPermissions(userContext("editor@example.com"), &proto.GetReportPermissionsRequest{ReportId: reportID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestLinkSharing(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	reportID, _ := createTestQuery(t, db, "author@example.com", "select 1")
+
+	// new reports are shared by link
+	report, err := s.getReport(userContext("other@example.com"), reportID)
+	require.NoError(t, err)
+	require.NotNil(t, report)
+	require.True(t, report.LinkSharing)
+
+	// granting permission does not change link sharing
+	setTestPermission(t, s, reportID, "viewer@example.com", proto.ReportPermission_ROLE_VIEWER)
+	report, err = s.getReport(userContext("other@example.com"), reportID)
+	require.NoError(t, err)
+	require.NotNil(t, report)
+
+	_, err = s.SetLinkSharing(userContext("viewer@example.com"), &proto.SetLinkSharingRequest{ReportId: reportID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.SetLinkSharing(userContext("author@example.com"), &proto.SetLinkSharingRequest{ReportId: reportID})
+	require.NoError(t, err)
+
+	report, err = s.getReport(userContext("other@example.com"), reportID)
+	require.NoError(t, err)
+	require.Nil(t, report)
+	report, err = s.getReport(userContext("viewer@example.com"), reportID)
+	require.NoError(t, err)
+	require.NotNil(t, report)
+	require.False(t, report.LinkSharing)
+	require.False(t, report.CanWrite)
+}
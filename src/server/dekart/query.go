@@ -3,11 +3,14 @@ package dekart
 import (
 	"context"
 	"crypto/sha1"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"dekart/src/proto"
 	"dekart/src/server/job"
+	"dekart/src/server/metrics"
 	"dekart/src/server/user"
 
 	"github.com/google/uuid"
@@ -36,10 +39,18 @@ func (s Server) CreateQuery(ctx context.Context, req *proto.CreateQueryRequest)
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
+	datasource, ok := s.datasources.Get(req.DatasourceId)
+	if !ok {
+		err := fmt.Errorf("datasource %s is not configured", req.DatasourceId)
+		log.Warn().Err(err).Send()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	id := newUUID()
 	_, err = s.db.ExecContext(ctx,
-		`insert into queries (id, query_text) values ($1, '')`,
+		`insert into queries (id, query_text, datasource_id) values ($1, '', $2)`,
 		id,
+		s.datasources.StoredID(datasource.ID),
 	)
 	if err != nil {
 		log.Err(err).Send()
@@ -78,25 +89,39 @@ func (e *queryWasNotUpdated) Error() string {
 	return "query was not updated"
 }
 
-func (s Server) storeQuerySync(ctx context.Context, queryID string, queryText string, prevQuerySourceId string) error {
+// querySourceID is name of query text object in storage
+func querySourceID(queryText string) string {
 	h := sha1.New()
-	queryTextByte := []byte(queryText)
-	h.Write(queryTextByte)
-	newQuerySourceId := fmt.Sprintf("%x", h.Sum(nil))
+	h.Write([]byte(queryText))
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// execer runs statements in database or in transaction
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// writeQuerySource stores query text object in storage and returns its query_source_id
+func (s Server) writeQuerySource(ctx context.Context, queryText string) (string, error) {
+	newQuerySourceId := querySourceID(queryText)
 	storageWriter := s.storage.GetObject(fmt.Sprintf("%s.sql", newQuerySourceId)).GetWriter(ctx)
-	_, err := storageWriter.Write(queryTextByte)
+	_, err := storageWriter.Write([]byte(queryText))
 	if err != nil {
 		log.Err(err).Msg("Error writing query_text to storage")
 		storageWriter.Close()
-		return err
+		return "", err
 	}
 	err = storageWriter.Close()
 	if err != nil {
 		log.Err(err).Msg("Error writing query_text to storage")
-		return err
+		return "", err
 	}
+	return newQuerySourceId, nil
+}
 
-	result, err := s.db.ExecContext(ctx,
+// updateQuerySource of query unless query_source_id was changed since prevQuerySourceId was read
+func updateQuerySource(ctx context.Context, db execer, queryID string, newQuerySourceId string, prevQuerySourceId string) error {
+	result, err := db.ExecContext(ctx,
 		`update queries set query_source_id=$1, query_source=$2 where id=$3 and query_source_id=$4`,
 		newQuerySourceId,
 		proto.Query_QUERY_SOURCE_STORAGE,
@@ -113,10 +138,23 @@ func (s Server) storeQuerySync(ctx context.Context, queryID string, queryText st
 	return nil
 }
 
+// storeQuerySync stores query text in storage and returns its query_source_id
+func (s Server) storeQuerySync(ctx context.Context, queryID string, queryText string, prevQuerySourceId string) (string, error) {
+	newQuerySourceId, err := s.writeQuerySource(ctx, queryText)
+	if err != nil {
+		return "", err
+	}
+	err = updateQuerySource(ctx, s.db, queryID, newQuerySourceId, prevQuerySourceId)
+	if err != nil {
+		return "", err
+	}
+	return newQuerySourceId, nil
+}
+
 func (s Server) storeQuery(reportID string, queryID string, queryText string, prevQuerySourceId string) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
-	err := s.storeQuerySync(ctx, queryID, queryText, prevQuerySourceId)
+	_, err := s.storeQuerySync(ctx, queryID, queryText, prevQuerySourceId)
 	if _, ok := err.(*queryWasNotUpdated); ok {
 		log.Warn().Msg("Query text not updated")
 		return
@@ -128,10 +166,26 @@ func (s Server) storeQuery(reportID string, queryID string, queryText string, pr
 	s.reportStreams.Ping(reportID)
 }
 
-func (s Server) updateJobStatus(job job.Job, jobStatus chan int32) {
+// marshalSchema of job result to JSON stored in queries.schema
+func marshalSchema(schema []job.Column) string {
+	if schema == nil {
+		return "[]"
+	}
+	b, err := json.Marshal(schema)
+	if err != nil {
+		log.Err(err).Msg("Cannot marshal result schema")
+		return "[]"
+	}
+	return string(b)
+}
+
+func (s Server) updateJobStatus(job job.Job, jobStatus chan int32, datasourceID string) {
+	metrics.JobsRunning.WithLabelValues(datasourceID).Inc()
+	lastStatus := int32(proto.Query_JOB_STATUS_UNSPECIFIED)
 	for {
 		select {
 		case status := <-jobStatus:
+			lastStatus = status
 			log.Debug().Str("query_id", job.GetQueryID()).Int32("status", status).Msg("Job status changed")
 			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 			var err error
@@ -145,12 +199,15 @@ func (s Server) updateJobStatus(job job.Job, jobStatus chan int32) {
 						job_started = CURRENT_TIMESTAMP,
 						total_rows = 0,
 						bytes_processed = 0,
-						result_size = 0
-					where id  = $2`,
+						result_size = 0,
+						schema = '[]',
+						job_truncation = ''
+					where id  = $2 and job_id = $5`,
 					status,
 					job.GetQueryID(),
 					job.Err(),
 					job.GetResultID(),
+					job.GetID(),
 				)
 
 			} else {
@@ -162,8 +219,10 @@ func (s Server) updateJobStatus(job job.Job, jobStatus chan int32) {
 						job_result_id = $4,
 						total_rows = $5,
 						bytes_processed = $6,
-						result_size = $7
-					where id  = $2`,
+						result_size = $7,
+						schema = $8,
+						job_truncation = $9
+					where id  = $2 and job_id = $10`,
 					status,
 					job.GetQueryID(),
 					job.Err(),
@@ -171,37 +230,76 @@ func (s Server) updateJobStatus(job job.Job, jobStatus chan int32) {
 					job.GetTotalRows(),
 					job.GetProcessedBytes(),
 					job.GetResultSize(),
+					marshalSchema(job.GetSchema()),
+					job.GetTruncation(),
+					job.GetID(),
 				)
 			}
-			cancel()
 			if err != nil {
+				cancel()
 				log.Fatal().Err(err).Send()
 			}
+			err = s.updateTrackedJob(ctx, job, status)
+			cancel()
+			if err != nil {
+				log.Err(err).Str("query_id", job.GetQueryID()).Msg("Cannot update tracked job")
+			}
 			s.reportStreams.Ping(job.GetReportID())
 		case <-job.GetCtx().Done():
+			observeJobFinished(job, lastStatus, datasourceID)
+			if job.IsStopped() {
+				// job stopped on shutdown stays tracked and is released to other instances
+				return
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			err := s.updateTrackedJob(ctx, job, int32(proto.Query_JOB_STATUS_UNSPECIFIED))
+			cancel()
+			if err != nil {
+				log.Err(err).Str("query_id", job.GetQueryID()).Msg("Cannot remove tracked job")
+			}
 			return
 		}
 	}
 }
 
+// observeJobFinished updates job metrics when job context is done
+func observeJobFinished(job job.Job, lastStatus int32, datasourceID string) {
+	metrics.JobsRunning.WithLabelValues(datasourceID).Dec()
+	switch {
+	case job.Err() != "":
+		metrics.JobsFinished.WithLabelValues(datasourceID, metrics.JobFailed).Inc()
+	case lastStatus == int32(proto.Query_JOB_STATUS_DONE):
+		metrics.JobsFinished.WithLabelValues(datasourceID, metrics.JobDone).Inc()
+		metrics.JobProcessedBytes.WithLabelValues(datasourceID).Add(float64(job.GetProcessedBytes()))
+		metrics.JobResultSize.WithLabelValues(datasourceID).Observe(float64(job.GetResultSize()))
+	default:
+		metrics.JobsFinished.WithLabelValues(datasourceID, metrics.JobCancelled).Inc()
+	}
+}
+
 // RunQuery job against database
 func (s Server) RunQuery(ctx context.Context, req *proto.RunQueryRequest) (*proto.RunQueryResponse, error) {
 	claims := user.GetClaims(ctx)
 	if claims == nil {
 		return nil, Unauthenticated
 	}
+	if s.isShuttingDown() {
+		return nil, shuttingDownStatusError
+	}
 	log.Debug().Str("query_id", req.QueryId).Int("QueryTextLen", len(req.QueryText)).Msg("RunQuery")
 	queriesRows, err := s.db.QueryContext(ctx,
 		`select 
 			reports.id,
-			queries.query_source_id
+			queries.query_source_id,
+			queries.datasource_id
 		from queries
 			left join datasets on queries.id = datasets.query_id
 			left join reports on (datasets.report_id = reports.id or queries.report_id = reports.id)
-		where queries.id = $1 and author_email = $2
+		where queries.id = $1 and report_role(reports.id, $2) >= $3
 		limit 1`,
 		req.QueryId,
 		claims.Email,
+		roleEditor,
 	)
 
 	if err != nil {
@@ -211,8 +309,9 @@ func (s Server) RunQuery(ctx context.Context, req *proto.RunQueryRequest) (*prot
 	defer queriesRows.Close()
 	var reportID string
 	var prevQuerySourceId string
+	var datasourceID string
 	for queriesRows.Next() {
-		err := queriesRows.Scan(&reportID, &prevQuerySourceId)
+		err := queriesRows.Scan(&reportID, &prevQuerySourceId, &datasourceID)
 		if err != nil {
 			log.Err(err).Send()
 			return nil, status.Error(codes.Internal, err.Error())
@@ -225,7 +324,38 @@ func (s Server) RunQuery(ctx context.Context, req *proto.RunQueryRequest) (*prot
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
-	err = s.storeQuerySync(ctx, req.QueryId, req.QueryText, prevQuerySourceId)
+	if req.DatasourceId != "" && req.DatasourceId != datasourceID {
+		requested, ok := s.datasources.Get(req.DatasourceId)
+		if !ok {
+			err := fmt.Errorf("datasource %s is not configured", req.DatasourceId)
+			log.Warn().Err(err).Send()
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		datasourceID = s.datasources.StoredID(requested.ID)
+		_, err = s.db.ExecContext(ctx,
+			`update queries set datasource_id=$1 where id=$2`,
+			datasourceID,
+			req.QueryId,
+		)
+		if err != nil {
+			log.Err(err).Send()
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	datasource, ok := s.datasources.Get(datasourceID)
+	if !ok {
+		err := fmt.Errorf("datasource %s of query %s is not configured", datasourceID, req.QueryId)
+		log.Warn().Err(err).Send()
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if (req.MaxRows > 0 || req.MaxResultBytes > 0) && !datasource.LimitsResult() {
+		err := fmt.Errorf("datasource %s does not limit result rows or size", datasource.ID)
+		log.Warn().Err(err).Send()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	querySourceID, err := s.storeQuerySync(ctx, req.QueryId, req.QueryText, prevQuerySourceId)
 
 	if err != nil {
 		code := codes.Internal
@@ -237,16 +367,61 @@ func (s Server) RunQuery(ctx context.Context, req *proto.RunQueryRequest) (*prot
 		}
 		return nil, status.Error(code, err.Error())
 	}
-	job, jobStatus, err := s.jobs.Create(reportID, req.QueryId, req.QueryText)
-	log.Debug().Str("jobID", job.GetID()).Msg("Job created")
+
+	params, paramsHash, err := s.resolveParameters(ctx, reportID, req.QueryText, req.Parameters, claims.Email)
 	if err != nil {
-		log.Error().Err(err).Send()
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, parametersStatusError(err)
+	}
+
+	if !req.ForceRefresh {
+		cached, err := s.findCachedResult(ctx, querySourceID, paramsHash, datasource)
+		if err != nil {
+			log.Err(err).Send()
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if cached != nil {
+			scopedEmail := ""
+			if userScoped(params) {
+				scopedEmail = claims.Email
+			}
+			err = s.useCachedResult(ctx, reportID, req.QueryId, paramsHash, scopedEmail, cached)
+			if err != nil {
+				log.Err(err).Send()
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			return &proto.RunQueryResponse{}, nil
+		}
+	}
+
+	err = s.checkQuota(ctx, claims.Email)
+	if err != nil {
+		log.Warn().Err(err).Str("query_id", req.QueryId).Send()
+		return nil, err
+	}
+
+	err = s.checkQueryEstimate(ctx, claims.Email, datasource, req.QueryText, params)
+	if err != nil {
+		log.Warn().Err(err).Str("query_id", req.QueryId).Send()
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	err = s.runJob(ctx, reportID, req.QueryId, req.QueryText, datasource, jobRun{
+		userEmail: claims.Email,
+		limits: datasource.Limits.Restrict(job.Limits{
+			Timeout:        time.Duration(req.TimeoutSeconds) * time.Second,
+			MaxRows:        req.MaxRows,
+			MaxResultBytes: req.MaxResultBytes,
+		}),
+		params:     params,
+		paramsHash: paramsHash,
+	})
+	if err == errShuttingDown {
+		return nil, shuttingDownStatusError
+	}
+	if status.Code(err) == codes.ResourceExhausted {
+		log.Warn().Err(err).Str("query_id", req.QueryId).Send()
+		return nil, err
 	}
-	obj := s.storage.GetObject(fmt.Sprintf("%s.csv", job.GetID()))
-	go s.updateJobStatus(job, jobStatus)
-	job.Status() <- int32(proto.Query_JOB_STATUS_PENDING)
-	err = job.Run(obj)
 	if err != nil {
 		log.Err(err).Send()
 		return nil, status.Error(codes.Internal, err.Error())
@@ -255,6 +430,53 @@ func (s Server) RunQuery(ctx context.Context, req *proto.RunQueryRequest) (*prot
 	return res, nil
 }
 
+// jobRun options of query job
+type jobRun struct {
+	userEmail  string // user running query or author of schedule
+	limits     job.Limits
+	params     []job.Parameter
+	paramsHash string // empty when query has no parameters
+}
+
+// runJob creates query job in datasource store and starts it
+func (s Server) runJob(ctx context.Context, reportID string, queryID string, queryText string, datasource job.Datasource, run jobRun) error {
+	if s.isShuttingDown() {
+		return errShuttingDown
+	}
+	job, jobStatus, err := datasource.Store.Create(reportID, queryID, queryText)
+	if err != nil {
+		return err
+	}
+	job.SetLimits(run.limits)
+	job.SetParameters(run.params)
+	job.StartSpan(ctx)
+	log.Debug().Str("jobID", job.GetID()).Msg("Job created")
+	metrics.JobsCreated.WithLabelValues(datasource.ID).Inc()
+	err = s.trackJobWithinQuota(ctx, job, datasource.ID, run.userEmail)
+	if err != nil {
+		job.Cancel()
+		return err
+	}
+	// user who ran the job is stored with result, result is cached for parameter values;
+	// only the latest job of the query updates its status
+	_, err = s.db.ExecContext(ctx,
+		`update queries set job_user_email=$1, job_parameters_hash=$2, job_id=$3, job_user_scoped=$4 where id=$5`,
+		run.userEmail,
+		run.paramsHash,
+		job.GetID(),
+		userScoped(run.params),
+		queryID,
+	)
+	if err != nil {
+		job.Cancel()
+		return err
+	}
+	obj := s.storage.GetObject(fmt.Sprintf("%s.csv", job.GetID()))
+	go s.updateJobStatus(job, jobStatus, datasource.ID)
+	job.Status() <- int32(proto.Query_JOB_STATUS_PENDING)
+	return job.Run(obj)
+}
+
 // CancelQuery jobs
 func (s Server) CancelQuery(ctx context.Context, req *proto.CancelQueryRequest) (*proto.CancelQueryResponse, error) {
 	claims := user.GetClaims(ctx)
@@ -272,10 +494,11 @@ func (s Server) CancelQuery(ctx context.Context, req *proto.CancelQueryRequest)
 		from queries
 			left join datasets on queries.id = datasets.query_id
 			left join reports on (datasets.report_id = reports.id or queries.report_id = reports.id)
-		where queries.id = $1 and author_email = $2
+		where queries.id = $1 and report_role(reports.id, $2) >= $3
 		limit 1`,
 		req.QueryId,
 		claims.Email,
+		roleEditor,
 	)
 	if err != nil {
 		log.Err(err).Send()
@@ -296,8 +519,13 @@ func (s Server) CancelQuery(ctx context.Context, req *proto.CancelQueryRequest)
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
-	if ok := s.jobs.Cancel(req.QueryId); !ok {
-		log.Debug().Msg("Query was not canceled in memory store, trying to cancel in database")
+	cancelled, err := s.cancelQueryJob(ctx, req.QueryId)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !cancelled {
+		log.Debug().Msg("Query job is not running, cancelling in database")
 		_, err = s.db.ExecContext(
 			ctx,
 			`update queries set
@@ -311,8 +539,28 @@ func (s Server) CancelQuery(ctx context.Context, req *proto.CancelQueryRequest)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 		s.reportStreams.Ping(reportID)
-	} else {
-		log.Debug().Msg("Query canceled in memory store")
 	}
 	return &proto.CancelQueryResponse{}, nil
 }
+
+// cancelQueryJob running on this instance or requests cancel from instance running it;
+// returns false when no job of the query is running
+func (s Server) cancelQueryJob(ctx context.Context, queryID string) (bool, error) {
+	if s.datasources.Cancel(queryID) {
+		log.Debug().Str("query_id", queryID).Msg("Query canceled in memory store")
+		return true, nil
+	}
+	// instance running the job cancels it on next heartbeat
+	result, err := s.db.ExecContext(ctx,
+		`update jobs set cancel_requested=true where query_id=$1`,
+		queryID,
+	)
+	if err != nil {
+		return false, err
+	}
+	requested, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return requested > 0, nil
+}
@@ -0,0 +1,136 @@
+package dekart
+
+import (
+	"context"
+	"dekart/src/proto"
+	"dekart/src/server/job"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// getQueryCacheTTL reads DEKART_QUERY_CACHE_TTL, for example 1h; cache is disabled when not set
+func getQueryCacheTTL() time.Duration {
+	value := os.Getenv("DEKART_QUERY_CACHE_TTL")
+	if value == "" {
+		return 0
+	}
+	ttl, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatal().Err(err).Str("DEKART_QUERY_CACHE_TTL", value).Msg("Cannot parse query cache TTL")
+	}
+	return ttl
+}
+
+type cachedResult struct {
+	jobResultID    string
+	jobStarted     time.Time
+	totalRows      int64
+	bytesProcessed int64
+	resultSize     int64
+	schema         string
+}
+
+// findCachedResult returns result of the same query text and parameter values run in the same datasource within cache TTL;
+// results truncated by limits of their run are not reused, later run may have other limits
+func (s Server) findCachedResult(ctx context.Context, querySourceID string, paramsHash string, datasource job.Datasource) (*cachedResult, error) {
+	if s.queryCacheTTL <= 0 {
+		return nil, nil
+	}
+	rows, err := s.db.QueryContext(ctx,
+		`select
+			job_result_id,
+			job_started,
+			total_rows,
+			bytes_processed,
+			result_size,
+			schema
+		from queries
+		where query_source_id = $1
+			and (datasource_id = $2 or ($3 and datasource_id = ''))
+			and job_status = $4
+			and job_result_id is not null
+			and job_started > CURRENT_TIMESTAMP - $5 * interval '1 second'
+			and job_parameters_hash = $6
+			and job_truncation = ''
+		order by job_started desc
+		limit 1`,
+		querySourceID,
+		datasource.ID,
+		datasource.ID == s.datasources.Default().ID,
+		int32(proto.Query_JOB_STATUS_DONE),
+		s.queryCacheTTL.Seconds(),
+		paramsHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	result := &cachedResult{}
+	err = rows.Scan(
+		&result.jobResultID,
+		&result.jobStarted,
+		&result.totalRows,
+		&result.bytesProcessed,
+		&result.resultSize,
+		&result.schema,
+	)
+	if err != nil {
+		return nil, err
+	}
+	// result object may be removed from storage by lifecycle rules
+	_, err = s.storage.GetObject(fmt.Sprintf("%s.csv", result.jobResultID)).GetCreatedAt(ctx)
+	if err != nil {
+		log.Warn().Err(err).Str("job_result_id", result.jobResultID).Msg("Cached result is not available")
+		return nil, nil
+	}
+	return result, nil
+}
+
+// useCachedResult completes query with cached result instead of running job; job of the query still running
+// is cancelled and cannot update query anymore. Cached result is not counted in daily quota of the user.
+// scopedEmail is set when result depends on user, result is shown only to that user then
+func (s Server) useCachedResult(ctx context.Context, reportID string, queryID string, paramsHash string, scopedEmail string, result *cachedResult) error {
+	_, err := s.cancelQueryJob(ctx, queryID)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx,
+		`update queries set
+			job_status = $1,
+			job_error = '',
+			job_result_id = $2,
+			job_started = $3,
+			total_rows = $4,
+			bytes_processed = $5,
+			result_size = $6,
+			schema = $7,
+			job_truncation = '',
+			job_user_email = $8,
+			job_user_scoped = $8 <> '',
+			job_parameters_hash = $9,
+			job_id = null
+		where id = $10`,
+		int32(proto.Query_JOB_STATUS_DONE),
+		result.jobResultID,
+		result.jobStarted,
+		result.totalRows,
+		result.bytesProcessed,
+		result.resultSize,
+		result.schema,
+		scopedEmail,
+		paramsHash,
+		queryID,
+	)
+	if err != nil {
+		return err
+	}
+	log.Debug().Str("query_id", queryID).Str("job_result_id", result.jobResultID).Msg("Using cached query result")
+	s.reportStreams.Ping(reportID)
+	return nil
+}
@@ -0,0 +1,130 @@
+//go:build integration
+
+package dekart
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"dekart/src/proto"
+	"dekart/src/server/job"
+
+	"github.com/stretchr/testify/require"
+)
+
+// insertCachedResult of query run started ago with result object in storage when stored
+func insertCachedResult(t *testing.T, s *Server, db *sql.DB, querySourceID string, datasourceID string, started time.Duration, stored bool) string {
+	_, queryID := createTestQuery(t, db, "author@example.com", "select 1")
+	resultID := newUUID()
+	_, err := db.Exec(
+		`update queries set
+			query_source_id=$1,
+			datasource_id=$2,
+			job_status=$3,
+			job_result_id=$4,
+			job_started=CURRENT_TIMESTAMP - $5 * interval '1 second',
+			total_rows=2
+		where id=$6`,
+		querySourceID, datasourceID, int32(proto.Query_JOB_STATUS_DONE), resultID, started.Seconds(), queryID,
+	)
+	require.NoError(t, err)
+	if stored {
+		writer := s.storage.GetObject(fmt.Sprintf("%s.csv", resultID)).GetWriter(context.Background())
+		_, err = writer.Write([]byte("a\n1\n2\n"))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+	}
+	return resultID
+}
+
+func TestFindCachedResult(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	s.datasources.Register("other", "TEST", &testStore{}, job.Limits{})
+	s.queryCacheTTL = time.Hour
+	ctx := context.Background()
+	datasource, _ := s.datasources.Get("")
+	other, _ := s.datasources.Get("other")
+
+	resultID := insertCachedResult(t, s, db, "cached", "", time.Minute, true)
+	insertCachedResult(t, s, db, "cached", "", 2*time.Minute, true)
+	result, err := s.findCachedResult(ctx, "cached", "", datasource)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, resultID, result.jobResultID)
+	require.Equal(t, int64(2), result.totalRows)
+
+	// other parameter values
+	result, err = s.findCachedResult(ctx, "cached", "hash", datasource)
+	require.NoError(t, err)
+	require.Nil(t, result)
+
+	// other datasource
+	result, err = s.findCachedResult(ctx, "cached", "", other)
+	require.NoError(t, err)
+	require.Nil(t, result)
+	otherResultID := insertCachedResult(t, s, db, "cached", "other", time.Minute, true)
+	result, err = s.findCachedResult(ctx, "cached", "", other)
+	require.NoError(t, err)
+	require.Equal(t, otherResultID, result.jobResultID)
+
+	// result truncated by limits is not reused
+	truncatedID := insertCachedResult(t, s, db, "truncated", "", time.Minute, true)
+	_, err = db.Exec(`update queries set job_truncation='row limit of 2 reached' where job_result_id=$1`, truncatedID)
+	require.NoError(t, err)
+	result, err = s.findCachedResult(ctx, "truncated", "", datasource)
+	require.NoError(t, err)
+	require.Nil(t, result)
+
+	// expired
+	insertCachedResult(t, s, db, "expired", "", 2*time.Hour, true)
+	result, err = s.findCachedResult(ctx, "expired", "", datasource)
+	require.NoError(t, err)
+	require.Nil(t, result)
+
+	// result object removed from storage
+	insertCachedResult(t, s, db, "removed", "", time.Minute, false)
+	result, err = s.findCachedResult(ctx, "removed", "", datasource)
+	require.NoError(t, err)
+	require.Nil(t, result)
+
+	// cache disabled
+	s.queryCacheTTL = 0
+	result, err = s.findCachedResult(ctx, "cached", "", datasource)
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
+
+func TestUseCachedResultCancelsJob(t *testing.T) {
+	db := newTestDB(t)
+	s, store := newTestServer(t, db)
+	s.queryCacheTTL = time.Hour
+	ctx := context.Background()
+	datasource, _ := s.datasources.Get("")
+
+	resultID := insertCachedResult(t, s, db, "cached", "", time.Minute, true)
+	result, err := s.findCachedResult(ctx, "cached", "", datasource)
+	require.NoError(t, err)
+
+	reportID, queryID := createTestQuery(t, db, "author@example.com", "select 1")
+	require.NoError(t, s.runJob(ctx, reportID, queryID, "select 1", datasource, jobRun{}))
+	waitFor(t, func() bool {
+		jobStatus, _ := queryStatus(t, db, queryID)
+		return jobStatus == int32(proto.Query_JOB_STATUS_RUNNING)
+	})
+
+	require.NoError(t, s.useCachedResult(ctx, reportID, queryID, "", "", result))
+	waitFor(t, func() bool { return store.RunningJobs() == 0 })
+	waitFor(t, func() bool { return countJobs(t, db, "query_id=$1", queryID) == 0 })
+
+	// cancelled job does not overwrite cached result
+	var jobStatus int32
+	var jobResultID string
+	err = db.QueryRow(`select job_status, job_result_id from queries where id=$1`, queryID).Scan(&jobStatus, &jobResultID)
+	require.NoError(t, err)
+	require.Equal(t, int32(proto.Query_JOB_STATUS_DONE), jobStatus)
+	require.Equal(t, resultID, jobResultID)
+}
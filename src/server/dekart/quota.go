@@ -0,0 +1,167 @@
+package dekart
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+
+	"dekart/src/proto"
+	"dekart/src/server/job"
+	"dekart/src/server/user"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// quotas of query jobs, 0 means no limit; running jobs are counted in jobs table
+// and finished jobs in job_usage table so limits apply to all server instances
+type quotas struct {
+	maxJobs           int64 // running jobs in deployment
+	maxUserJobs       int64 // running jobs of user
+	maxUserDailyBytes int64 // bytes processed by jobs user ran since start of the day
+}
+
+// enabled when any limit applies to jobs of user; only deployment limit applies when email is empty
+func (q quotas) enabled(email string) bool {
+	return q.maxJobs > 0 || email != "" && (q.maxUserJobs > 0 || q.maxUserDailyBytes > 0)
+}
+
+// getQuotas reads DEKART_MAX_CONCURRENT_JOBS, DEKART_MAX_CONCURRENT_JOBS_PER_USER and DEKART_MAX_DAILY_BYTES_PER_USER
+func getQuotas() quotas {
+	return quotas{
+		maxJobs:           getLimitEnv("DEKART_MAX_CONCURRENT_JOBS"),
+		maxUserJobs:       getLimitEnv("DEKART_MAX_CONCURRENT_JOBS_PER_USER"),
+		maxUserDailyBytes: getLimitEnv("DEKART_MAX_DAILY_BYTES_PER_USER"),
+	}
+}
+
+func getLimitEnv(name string) int64 {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0
+	}
+	limit, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || limit < 0 {
+		log.Fatal().Str(name, value).Msg("Cannot parse limit")
+	}
+	return limit
+}
+
+type quotaUsage struct {
+	jobs           int64
+	userJobs       int64
+	userDailyBytes int64
+}
+
+// querier reads rows from database or in transaction
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// getQuotaUsage counts running jobs and bytes processed today by finished jobs of user;
+// results reused from query cache are not counted
+func getQuotaUsage(ctx context.Context, db querier, email string) (quotaUsage, error) {
+	var usage quotaUsage
+	err := db.QueryRowContext(ctx,
+		`select
+			(select count(*) from jobs),
+			(select count(*) from jobs where user_email = $1),
+			(select coalesce(sum(bytes_processed), 0) from job_usage
+				where user_email = $1 and usage_date = CURRENT_DATE)`,
+		email,
+	).Scan(&usage.jobs, &usage.userJobs, &usage.userDailyBytes)
+	return usage, err
+}
+
+// checkQuota returns ResourceExhausted error when user cannot start another job;
+// it fails early before job is created, limits are enforced when job is tracked by trackJobWithinQuota
+func (s Server) checkQuota(ctx context.Context, email string) error {
+	if !s.quotas.enabled(email) {
+		return nil
+	}
+	usage, err := getQuotaUsage(ctx, s.db, email)
+	if err != nil {
+		log.Err(err).Send()
+		return status.Error(codes.Internal, err.Error())
+	}
+	return s.quotas.check(usage, email)
+}
+
+// check usage against limits, only deployment limit applies when email is empty
+func (q quotas) check(usage quotaUsage, email string) error {
+	if q.maxJobs > 0 && usage.jobs >= q.maxJobs {
+		err := fmt.Errorf("%d queries are running, try again later", usage.jobs)
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if email == "" {
+		return nil
+	}
+	if q.maxUserJobs > 0 && usage.userJobs >= q.maxUserJobs {
+		err := fmt.Errorf("you have %d running queries, limit is %d", usage.userJobs, q.maxUserJobs)
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if q.maxUserDailyBytes > 0 && usage.userDailyBytes >= q.maxUserDailyBytes {
+		err := fmt.Errorf("your queries processed %d bytes today, daily limit is %d bytes", usage.userDailyBytes, q.maxUserDailyBytes)
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return nil
+}
+
+// trackJobWithinQuota tracks new job when usage is within limits; usage is counted and job is inserted
+// under advisory lock, so concurrent runs on any instance cannot exceed limits. Lock is taken for
+// deployment when deployment limit is set, otherwise for user
+func (s Server) trackJobWithinQuota(ctx context.Context, job job.Job, datasourceID string, email string) error {
+	if !s.quotas.enabled(email) {
+		return s.trackJob(ctx, s.db, job, datasourceID, email)
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	lockKey := "dekart.jobs"
+	if s.quotas.maxJobs == 0 {
+		lockKey = "dekart.jobs." + email
+	}
+	_, err = tx.ExecContext(ctx, `select pg_advisory_xact_lock(hashtext($1))`, lockKey)
+	if err != nil {
+		return err
+	}
+	usage, err := getQuotaUsage(ctx, tx, email)
+	if err != nil {
+		return err
+	}
+	err = s.quotas.check(usage, email)
+	if err != nil {
+		return err
+	}
+	err = s.trackJob(ctx, tx, job, datasourceID, email)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetQuota returns quota usage of the current user
+func (s Server) GetQuota(ctx context.Context, req *proto.GetQuotaRequest) (*proto.GetQuotaResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	usage, err := getQuotaUsage(ctx, s.db, claims.Email)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &proto.GetQuotaResponse{
+		RunningJobs:              usage.userJobs,
+		MaxRunningJobs:           s.quotas.maxUserJobs,
+		DeploymentRunningJobs:    usage.jobs,
+		MaxDeploymentRunningJobs: s.quotas.maxJobs,
+		BytesProcessedToday:      usage.userDailyBytes,
+		MaxBytesProcessedPerDay:  s.quotas.maxUserDailyBytes,
+	}, nil
+}
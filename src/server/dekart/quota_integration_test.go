@@ -0,0 +1,86 @@
+//go:build integration
+
+package dekart
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"dekart/src/proto"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTrackJobWithinQuota(t *testing.T) {
+	db := newTestDB(t)
+	s, store := newTestServer(t, db)
+	s.quotas.maxUserJobs = 1
+	ctx := context.Background()
+	reportID, queryID := createTestQuery(t, db, "user@example.com", "select 1")
+
+	// concurrent runs of user cannot exceed limit
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		j, _, err := store.Create(reportID, queryID, "select 1")
+		require.NoError(t, err)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- s.trackJobWithinQuota(ctx, j, "test", "user@example.com")
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	tracked := 0
+	for err := range errs {
+		if err == nil {
+			tracked++
+			continue
+		}
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	}
+	require.Equal(t, 1, tracked)
+	require.Equal(t, 1, countJobs(t, db, "user_email=$1", "user@example.com"))
+
+	// limit of other user is not affected
+	j, _, err := store.Create(reportID, queryID, "select 1")
+	require.NoError(t, err)
+	require.NoError(t, s.trackJobWithinQuota(ctx, j, "test", "other@example.com"))
+}
+
+func TestDailyBytesQuota(t *testing.T) {
+	db := newTestDB(t)
+	s, store := newTestServer(t, db)
+	s.quotas.maxUserDailyBytes = 100
+	ctx := context.Background()
+	reportID, queryID := createTestQuery(t, db, "user@example.com", "select 1")
+
+	// every finished job is recorded, later runs of the same query do not replace usage
+	for _, bytes := range []int64{60, 40} {
+		j, _, err := store.Create(reportID, queryID, "select 1")
+		require.NoError(t, err)
+		require.NoError(t, s.trackJobWithinQuota(ctx, j, "test", "user@example.com"))
+		j.(*testJob).ProcessedBytes = bytes
+		require.NoError(t, s.updateTrackedJob(ctx, j, int32(proto.Query_JOB_STATUS_DONE)))
+		// job removed on cancel is not counted twice
+		require.NoError(t, s.updateTrackedJob(ctx, j, int32(proto.Query_JOB_STATUS_UNSPECIFIED)))
+	}
+
+	res, err := s.GetQuota(userContext("user@example.com"), &proto.GetQuotaRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), res.BytesProcessedToday)
+	require.Equal(t, codes.ResourceExhausted, status.Code(s.checkQuota(ctx, "user@example.com")))
+
+	j, _, err := store.Create(reportID, queryID, "select 1")
+	require.NoError(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(s.trackJobWithinQuota(ctx, j, "test", "user@example.com")))
+
+	// usage of previous days is not counted
+	_, err = db.Exec(`update job_usage set usage_date = CURRENT_DATE - 1`)
+	require.NoError(t, err)
+	require.NoError(t, s.checkQuota(ctx, "user@example.com"))
+}
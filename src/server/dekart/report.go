@@ -4,13 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"dekart/src/proto"
+	"dekart/src/server/tracing"
 	"dekart/src/server/user"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -24,6 +27,8 @@ func newUUID() string {
 }
 
 func (s Server) getReport(ctx context.Context, reportID string) (*proto.Report, error) {
+	ctx, span := tracing.Start(ctx, "Server.getReport", attribute.String("report.id", reportID))
+	defer span.End()
 	claims := user.GetClaims(ctx)
 	if claims == nil {
 		log.Fatal().Msg("getReport require claims")
@@ -33,12 +38,21 @@ func (s Server) getReport(ctx context.Context, reportID string) (*proto.Report,
 			id,
 			case when map_config is null then '' else map_config end as map_config,
 			case when title is null then 'Untitled' else title end as title,
-			author_email = $2 as can_write,
+			report_role(id, $2) >= $3 as can_write,
+			author_email = $2 as is_author,
 			author_email,
-			discoverable
-		from reports where id=$1 and not archived limit 1`,
+			discoverable,
+			link_sharing
+		from reports
+		where id=$1 and not archived and (
+			report_role(id, $2) > 0
+			or discoverable
+			or link_sharing
+		)
+		limit 1`,
 		reportID,
 		claims.Email,
+		roleEditor,
 	)
 	if err != nil {
 		log.Err(err).Send()
@@ -53,8 +67,10 @@ func (s Server) getReport(ctx context.Context, reportID string) (*proto.Report,
 			&report.MapConfig,
 			&report.Title,
 			&report.CanWrite,
+			&report.IsAuthor,
 			&report.AuthorEmail,
 			&report.Discoverable,
+			&report.LinkSharing,
 		)
 		if err != nil {
 			log.Err(err).Send()
@@ -64,6 +80,11 @@ func (s Server) getReport(ctx context.Context, reportID string) (*proto.Report,
 	if report.Id == "" {
 		return nil, nil // not found
 	}
+	report.Parameters, err = s.getReportParameters(ctx, report.Id)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, err
+	}
 	return report, nil
 }
 
@@ -93,7 +114,8 @@ func (s Server) CreateReport(ctx context.Context, req *proto.CreateReportRequest
 
 func rollback(tx *sql.Tx) {
 	err := tx.Rollback()
-	if err != nil {
+	// allows to defer rollback of committed transaction
+	if err != nil && err != sql.ErrTxDone {
 		log.Err(err).Send()
 	}
 }
@@ -110,7 +132,8 @@ func updateDatasetIds(report *proto.Report, datasets []*proto.Dataset) (newMapCo
 	return newMapConfig, newDatasetIds
 }
 
-func (s Server) commitReportWithDatasets(ctx context.Context, report *proto.Report, datasets []*proto.Dataset) error {
+// commitReportWithDatasets creates report with copies of datasets and parameters of source report
+func (s Server) commitReportWithDatasets(ctx context.Context, sourceReportID string, report *proto.Report, datasets []*proto.Dataset) error {
 	claims := user.GetClaims(ctx)
 	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
@@ -140,12 +163,14 @@ func (s Server) commitReportWithDatasets(ctx context.Context, report *proto.Repo
 						id,
 						query_text,
 						query_source,
-						query_source_id
+						query_source_id,
+						datasource_id
 					) select 
 						$1,
 						query_text,
 						query_source,
-						query_source_id
+						query_source_id,
+						datasource_id
 					from queries where id=$2`,
 				newQueryID,
 				dataset.QueryId,
@@ -166,7 +191,9 @@ func (s Server) commitReportWithDatasets(ctx context.Context, report *proto.Repo
 					size,
 					mime_type,
 					file_status,
-					upload_error					
+					upload_error,
+					row_count,
+					schema
 				) select
 					$1,
 					file_source_id,
@@ -174,7 +201,9 @@ func (s Server) commitReportWithDatasets(ctx context.Context, report *proto.Repo
 					size,
 					mime_type,
 					file_status,
-					upload_error					
+					upload_error,
+					row_count,
+					schema
 				from files where id=$2`, newFileID, dataset.FileId)
 			if err != nil {
 				log.Debug().Err(err).Send()
@@ -197,6 +226,11 @@ func (s Server) commitReportWithDatasets(ctx context.Context, report *proto.Repo
 			return err
 		}
 	}
+	err = copyReportParameters(ctx, tx, sourceReportID, report.Id)
+	if err != nil {
+		rollback(tx)
+		return err
+	}
 	err = tx.Commit()
 	return err
 }
@@ -233,7 +267,7 @@ func (s Server) ForkReport(ctx context.Context, req *proto.ForkReportRequest) (*
 		return nil, err
 	}
 
-	err = s.commitReportWithDatasets(ctx, report, datasets)
+	err = s.commitReportWithDatasets(ctx, req.ReportId, report, datasets)
 	if err != nil {
 		log.Err(err).Send()
 		return nil, err
@@ -253,15 +287,43 @@ func (s Server) UpdateReport(ctx context.Context, req *proto.UpdateReportRequest
 	if req.Report == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "req.Report == nil")
 	}
-	result, err := s.db.ExecContext(ctx,
+	if err := s.checkCanWriteReport(ctx, req.Report.Id, claims.Email); err != nil {
+		return nil, err
+	}
+	if err := s.checkReportQueries(ctx, req.Report.Id, req.Query); err != nil {
+		return nil, err
+	}
+	// query texts are stored before report is updated so revision refers only to stored query texts
+	querySourceIDs := make(map[string]string)
+	for _, query := range req.Query {
+		sourceID, err := s.writeQuerySource(ctx, query.QueryText)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		querySourceIDs[query.Id] = sourceID
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rollback(tx)
+	// keep previous state if it was not recorded yet, it was not made by current editor
+	err = saveRevision(ctx, tx, req.Report.Id, "")
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	result, err := tx.ExecContext(ctx,
 		`update
 			reports
 		set map_config=$1, title=$2
-		where id=$3 and author_email=$4`,
+		where id=$3 and report_role(id, $4) >= $5`,
 		req.Report.MapConfig,
 		req.Report.Title,
 		req.Report.Id,
 		claims.Email,
+		roleEditor,
 	)
 	if err != nil {
 		log.Err(err).Send()
@@ -282,9 +344,26 @@ func (s Server) UpdateReport(ctx context.Context, req *proto.UpdateReportRequest
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
-	// save queries
 	for _, query := range req.Query {
-		go s.storeQuery(req.Report.Id, query.Id, query.QueryText, query.QuerySourceId)
+		err = updateQuerySource(ctx, tx, query.Id, querySourceIDs[query.Id], query.QuerySourceId)
+		if _, ok := err.(*queryWasNotUpdated); ok {
+			log.Warn().Str("query_id", query.Id).Msg("Query text not updated")
+			continue
+		}
+		if err != nil {
+			log.Err(err).Send()
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	err = saveRevision(ctx, tx, req.Report.Id, claims.Email)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	s.reportStreams.Ping(req.Report.Id)
@@ -292,6 +371,54 @@ func (s Server) UpdateReport(ctx context.Context, req *proto.UpdateReportRequest
 	return &proto.UpdateReportResponse{}, nil
 }
 
+// checkReportQueries returns grpc error unless every query belongs to report directly or through its dataset
+func (s Server) checkReportQueries(ctx context.Context, reportID string, queries []*proto.Query) error {
+	if len(queries) == 0 {
+		return nil
+	}
+	queryIDs := make([]string, len(queries))
+	for i, query := range queries {
+		id, err := uuid.Parse(query.Id)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid query id %s", query.Id)
+		}
+		queryIDs[i] = id.String()
+	}
+	rows, err := s.db.QueryContext(ctx,
+		`select queries.id
+		from queries left join datasets on datasets.query_id = queries.id
+		where queries.id = ANY($1) and (queries.report_id = $2 or datasets.report_id = $2)`,
+		pq.Array(queryIDs),
+		reportID,
+	)
+	if err != nil {
+		log.Err(err).Send()
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+	found := make(map[string]bool)
+	for rows.Next() {
+		var queryID string
+		if err := rows.Scan(&queryID); err != nil {
+			log.Err(err).Send()
+			return status.Error(codes.Internal, err.Error())
+		}
+		found[queryID] = true
+	}
+	if err := rows.Err(); err != nil {
+		log.Err(err).Send()
+		return status.Error(codes.Internal, err.Error())
+	}
+	for _, queryID := range queryIDs {
+		if !found[queryID] {
+			err := fmt.Errorf("query %s not found in report %s", queryID, reportID)
+			log.Warn().Err(err).Send()
+			return status.Error(codes.NotFound, err.Error())
+		}
+	}
+	return nil
+}
+
 func (s Server) SetDiscoverable(ctx context.Context, req *proto.SetDiscoverableRequest) (*proto.SetDiscoverableResponse, error) {
 	claims := user.GetClaims(ctx)
 	if claims == nil {
@@ -0,0 +1,366 @@
+package dekart
+
+import (
+	"context"
+	"database/sql"
+	"dekart/src/proto"
+	"dekart/src/server/jsondiff"
+	"dekart/src/server/user"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reportState is what is saved in report revision
+type reportState struct {
+	title          string
+	mapConfig      string
+	querySourceIDs map[string]string
+}
+
+// getReportState reads current report state and locks report row until transaction ends
+func getReportState(ctx context.Context, tx *sql.Tx, reportID string) (*reportState, error) {
+	var title sql.NullString
+	var mapConfig sql.NullString
+	err := tx.QueryRowContext(ctx,
+		`select title, map_config from reports where id=$1 for update`,
+		reportID,
+	).Scan(&title, &mapConfig)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := &reportState{
+		title:          title.String,
+		mapConfig:      mapConfig.String,
+		querySourceIDs: make(map[string]string),
+	}
+	rows, err := tx.QueryContext(ctx,
+		`select queries.id, queries.query_source_id from queries
+			left join datasets on queries.id = datasets.query_id
+		where datasets.report_id = $1 or queries.report_id = $1`,
+		reportID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var queryID string
+		var sourceID sql.NullString
+		if err := rows.Scan(&queryID, &sourceID); err != nil {
+			return nil, err
+		}
+		state.querySourceIDs[queryID] = sourceID.String
+	}
+	return state, rows.Err()
+}
+
+// saveRevision records current report state unless it equals the last revision;
+// email is empty when user who made the state is not known
+func saveRevision(ctx context.Context, tx *sql.Tx, reportID string, email string) error {
+	state, err := getReportState(ctx, tx, reportID)
+	if err != nil || state == nil {
+		return err
+	}
+	var lastTitle sql.NullString
+	var lastMapConfig sql.NullString
+	var lastQuerySourceIDs []byte
+	err = tx.QueryRowContext(ctx,
+		`select title, map_config, query_source_ids from report_revisions
+		where report_id=$1 order by revision desc limit 1`,
+		reportID,
+	).Scan(&lastTitle, &lastMapConfig, &lastQuerySourceIDs)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if err == nil {
+		last := make(map[string]string)
+		if err := json.Unmarshal(lastQuerySourceIDs, &last); err != nil {
+			return err
+		}
+		if lastTitle.String == state.title && lastMapConfig.String == state.mapConfig && reflect.DeepEqual(last, state.querySourceIDs) {
+			return nil
+		}
+	}
+	querySourceIDsJSON, err := json.Marshal(state.querySourceIDs)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx,
+		`insert into report_revisions (id, report_id, revision, title, map_config, query_source_ids, author_email)
+		select $1, $2, coalesce(max(revision), 0) + 1, $3, $4, $5, nullif($6, '')
+		from report_revisions where report_id=$2`,
+		newUUID(),
+		reportID,
+		state.title,
+		state.mapConfig,
+		string(querySourceIDsJSON),
+		email,
+	)
+	return err
+}
+
+func rowsToRevisions(rows *sql.Rows) ([]*proto.ReportRevision, error) {
+	revisions := make([]*proto.ReportRevision, 0)
+	for rows.Next() {
+		revision := proto.ReportRevision{}
+		var title sql.NullString
+		var mapConfig sql.NullString
+		var querySourceIDs []byte
+		var authorEmail sql.NullString
+		var createdAt time.Time
+		err := rows.Scan(
+			&revision.Id,
+			&revision.ReportId,
+			&revision.Revision,
+			&title,
+			&mapConfig,
+			&querySourceIDs,
+			&authorEmail,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		revision.Title = title.String
+		revision.MapConfig = mapConfig.String
+		revision.AuthorEmail = authorEmail.String
+		revision.CreatedAt = createdAt.Unix()
+		if err := json.Unmarshal(querySourceIDs, &revision.QuerySourceIds); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, &revision)
+	}
+	return revisions, rows.Err()
+}
+
+// getRevision returns revision of report by id, empty id means latest revision
+func (s Server) getRevision(ctx context.Context, reportID string, revisionID string) (*proto.ReportRevision, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`select id, report_id, revision, title, map_config, query_source_ids, author_email, created_at
+		from report_revisions
+		where report_id=$1 and ($2 = '' or id::text = $2)
+		order by revision desc limit 1`,
+		reportID,
+		revisionID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	revisions, err := rowsToRevisions(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		return nil, nil
+	}
+	return revisions[0], nil
+}
+
+// GetReportRevisions lists report revisions starting from the latest, without map config
+func (s Server) GetReportRevisions(ctx context.Context, req *proto.GetReportRevisionsRequest) (*proto.GetReportRevisionsResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	if err := s.checkCanWriteReport(ctx, req.ReportId, claims.Email); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(ctx,
+		`select id, report_id, revision, title, '', query_source_ids, author_email, created_at
+		from report_revisions
+		where report_id=$1
+		order by revision desc`,
+		req.ReportId,
+	)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+	revisions, err := rowsToRevisions(rows)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &proto.GetReportRevisionsResponse{Revisions: revisions}, nil
+}
+
+var revisionChangeKinds = map[jsondiff.Kind]proto.RevisionChange_Kind{
+	jsondiff.Added:   proto.RevisionChange_KIND_ADDED,
+	jsondiff.Removed: proto.RevisionChange_KIND_REMOVED,
+	jsondiff.Changed: proto.RevisionChange_KIND_CHANGED,
+}
+
+func diffRevisions(from, to *proto.ReportRevision) []*proto.RevisionChange {
+	changes := jsondiff.Diff("title", from.Title, to.Title)
+	changes = append(changes, jsondiff.Diff("map_config", jsondiff.Parse(from.MapConfig), jsondiff.Parse(to.MapConfig))...)
+	fromQueries := make(map[string]interface{})
+	for queryID, sourceID := range from.QuerySourceIds {
+		fromQueries[queryID] = sourceID
+	}
+	toQueries := make(map[string]interface{})
+	for queryID, sourceID := range to.QuerySourceIds {
+		toQueries[queryID] = sourceID
+	}
+	changes = append(changes, jsondiff.Diff("query_source_ids", fromQueries, toQueries)...)
+	res := make([]*proto.RevisionChange, len(changes))
+	for i, change := range changes {
+		res[i] = &proto.RevisionChange{
+			Path: change.Path,
+			Kind: revisionChangeKinds[change.Kind],
+			From: change.From,
+			To:   change.To,
+		}
+	}
+	return res
+}
+
+// DiffReportRevisions returns changes between two revisions of report
+func (s Server) DiffReportRevisions(ctx context.Context, req *proto.DiffReportRevisionsRequest) (*proto.DiffReportRevisionsResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	if err := s.checkCanWriteReport(ctx, req.ReportId, claims.Email); err != nil {
+		return nil, err
+	}
+	revisions := make([]*proto.ReportRevision, 2)
+	for i, revisionID := range []string{req.FromRevisionId, req.ToRevisionId} {
+		_, err := uuid.Parse(revisionID)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		revisions[i], err = s.getRevision(ctx, req.ReportId, revisionID)
+		if err != nil {
+			log.Err(err).Send()
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if revisions[i] == nil {
+			err := fmt.Errorf("revision not found id:%s", revisionID)
+			log.Warn().Err(err).Send()
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+	}
+	return &proto.DiffReportRevisionsResponse{
+		Changes: diffRevisions(revisions[0], revisions[1]),
+	}, nil
+}
+
+// RestoreReportRevision sets report title, map config and query texts from revision;
+// restore is recorded as a new revision so it can be reverted too
+func (s Server) RestoreReportRevision(ctx context.Context, req *proto.RestoreReportRevisionRequest) (*proto.RestoreReportRevisionResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	if err := s.checkCanWriteReport(ctx, req.ReportId, claims.Email); err != nil {
+		return nil, err
+	}
+	_, err := uuid.Parse(req.RevisionId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	revision, err := s.getRevision(ctx, req.ReportId, req.RevisionId)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if revision == nil {
+		err := fmt.Errorf("revision not found id:%s", req.RevisionId)
+		log.Warn().Err(err).Send()
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rollback(tx)
+	// keep current state if it was not recorded yet, it was not made by current user
+	err = saveRevision(ctx, tx, req.ReportId, "")
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	_, err = tx.ExecContext(ctx,
+		`update reports set map_config=$1, title=$2 where id=$3`,
+		revision.MapConfig,
+		revision.Title,
+		req.ReportId,
+	)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// query text objects are immutable and named by hash, so they are still in storage;
+	// datasets removed since the revision cannot be restored. Result of changed query
+	// does not match restored text, query has to be run again
+	restoredQueryIDs := make([]string, 0)
+	for queryID, sourceID := range revision.QuerySourceIds {
+		if sourceID == "" {
+			continue
+		}
+		result, err := tx.ExecContext(ctx,
+			`update queries set
+				query_source_id=$1,
+				query_source=$2,
+				job_status=$3,
+				job_error='',
+				job_result_id=null,
+				total_rows=0,
+				bytes_processed=0,
+				result_size=0,
+				schema='[]',
+				job_truncation='',
+				job_id=null
+			where id=$4 and query_source_id<>$1`,
+			sourceID,
+			proto.Query_QUERY_SOURCE_STORAGE,
+			int32(proto.Query_JOB_STATUS_UNSPECIFIED),
+			queryID,
+		)
+		if err != nil {
+			log.Err(err).Send()
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if restored, _ := result.RowsAffected(); restored > 0 {
+			restoredQueryIDs = append(restoredQueryIDs, queryID)
+		}
+	}
+	err = saveRevision(ctx, tx, req.ReportId, claims.Email)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// jobs of replaced query texts cannot update queries anymore
+	for _, queryID := range restoredQueryIDs {
+		if _, err := s.cancelQueryJob(ctx, queryID); err != nil {
+			log.Err(err).Str("query_id", queryID).Msg("Cannot cancel job of restored query")
+		}
+	}
+	s.reportStreams.Ping(req.ReportId)
+
+	latest, err := s.getRevision(ctx, req.ReportId, "")
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &proto.RestoreReportRevisionResponse{Revision: latest}, nil
+}
@@ -0,0 +1,161 @@
+//go:build integration
+
+package dekart
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"testing"
+
+	"dekart/src/proto"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func updateTestReport(t *testing.T, s *Server, reportID string, queryID string, queryText string, prevQuerySourceID string) {
+	_, err := s.UpdateReport(userContext("author@example.com"), &proto.UpdateReportRequest{
+		Report: &proto.Report{Id: reportID, Title: queryText, MapConfig: "{}"},
+		Query: []*proto.Query{
+			{Id: queryID, QueryText: queryText, QuerySourceId: prevQuerySourceID},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestUpdateReportRevision(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	ctx := context.Background()
+	reportID, queryID := createTestQuery(t, db, "author@example.com", "")
+	_, err := db.Exec(`update queries set query_source_id='' where id=$1`, queryID)
+	require.NoError(t, err)
+
+	updateTestReport(t, s, reportID, queryID, "select 2", "")
+	sourceID := querySourceID("select 2")
+	revision, err := s.getRevision(ctx, reportID, "")
+	require.NoError(t, err)
+	require.Equal(t, sourceID, revision.QuerySourceIds[queryID])
+	reader, err := s.storage.GetObject(fmt.Sprintf("%s.sql", sourceID)).GetReader(ctx)
+	require.NoError(t, err)
+	queryText, err := io.ReadAll(reader)
+	reader.Close()
+	require.NoError(t, err)
+	require.Equal(t, "select 2", string(queryText))
+
+	// query text changed meanwhile is not recorded in revision
+	updateTestReport(t, s, reportID, queryID, "select 3", "outdated")
+	revision, err = s.getRevision(ctx, reportID, "")
+	require.NoError(t, err)
+	require.Equal(t, sourceID, revision.QuerySourceIds[queryID])
+	require.Equal(t, "select 3", revision.Title)
+	var storedSourceID string
+	require.NoError(t, db.QueryRow(`select query_source_id from queries where id=$1`, queryID).Scan(&storedSourceID))
+	require.Equal(t, sourceID, storedSourceID)
+}
+
+func TestUpdateReportRevisionAuthor(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	reportID, queryID := createTestQuery(t, db, "author@example.com", "")
+	_, err := db.Exec(`update queries set query_source_id='' where id=$1`, queryID)
+	require.NoError(t, err)
+	setTestPermission(t, s, reportID, "editor@example.com", proto.ReportPermission_ROLE_EDITOR)
+
+	_, err = s.UpdateReport(userContext("editor@example.com"), &proto.UpdateReportRequest{
+		Report: &proto.Report{Id: reportID, Title: "edited", MapConfig: "{}"},
+	})
+	require.NoError(t, err)
+	res, err := s.GetReportRevisions(userContext("editor@example.com"), &proto.GetReportRevisionsRequest{ReportId: reportID})
+	require.NoError(t, err)
+	require.Len(t, res.Revisions, 2)
+	require.Equal(t, "edited", res.Revisions[0].Title)
+	require.Equal(t, "editor@example.com", res.Revisions[0].AuthorEmail)
+	// state before the edit was not made by editor
+	require.Empty(t, res.Revisions[1].AuthorEmail)
+}
+
+func TestRestoreReportRevision(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	ctx := context.Background()
+	reportID, queryID := createTestQuery(t, db, "author@example.com", "")
+	_, err := db.Exec(`update queries set query_source_id='' where id=$1`, queryID)
+	require.NoError(t, err)
+
+	updateTestReport(t, s, reportID, queryID, "select 2", "")
+	restored, err := s.getRevision(ctx, reportID, "")
+	require.NoError(t, err)
+	updateTestReport(t, s, reportID, queryID, "select 3", querySourceID("select 2"))
+	_, err = db.Exec(
+		`update queries set job_status=$1, job_result_id=$2, total_rows=5 where id=$3`,
+		int32(proto.Query_JOB_STATUS_DONE), newUUID(), queryID,
+	)
+	require.NoError(t, err)
+
+	_, err = s.RestoreReportRevision(userContext("author@example.com"), &proto.RestoreReportRevisionRequest{
+		ReportId:   reportID,
+		RevisionId: restored.Id,
+	})
+	require.NoError(t, err)
+
+	// result of query text which was replaced is not shown
+	var sourceID string
+	var jobStatus int32
+	var jobResultID sql.NullString
+	var totalRows int64
+	err = db.QueryRow(
+		`select query_source_id, job_status, job_result_id, total_rows from queries where id=$1`,
+		queryID,
+	).Scan(&sourceID, &jobStatus, &jobResultID, &totalRows)
+	require.NoError(t, err)
+	require.Equal(t, querySourceID("select 2"), sourceID)
+	require.Equal(t, int32(proto.Query_JOB_STATUS_UNSPECIFIED), jobStatus)
+	require.False(t, jobResultID.Valid)
+	require.Zero(t, totalRows)
+
+	latest, err := s.getRevision(ctx, reportID, "")
+	require.NoError(t, err)
+	require.Equal(t, "select 2", latest.Title)
+	require.Equal(t, querySourceID("select 2"), latest.QuerySourceIds[queryID])
+}
+
+func TestUpdateReportForeignQuery(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	reportID, _ := createTestQuery(t, db, "author@example.com", "select 1")
+	otherReportID, otherQueryID := createTestQuery(t, db, "other@example.com", "select 2")
+	_, err := db.Exec(`update queries set query_source_id='' where id=$1`, otherQueryID)
+	require.NoError(t, err)
+
+	_, err = s.UpdateReport(userContext("author@example.com"), &proto.UpdateReportRequest{
+		Report: &proto.Report{Id: reportID, Title: "title", MapConfig: "{}"},
+		Query:  []*proto.Query{{Id: otherQueryID, QueryText: "select 3"}},
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	var storedSourceID string
+	require.NoError(t, db.QueryRow(`select query_source_id from queries where id=$1`, otherQueryID).Scan(&storedSourceID))
+	require.Empty(t, storedSourceID)
+
+	// query of report dataset
+	datasetQueryID := newUUID()
+	_, err = db.Exec(`insert into queries (id, query_text, query_source_id) values ($1, '', '')`, datasetQueryID)
+	require.NoError(t, err)
+	_, err = db.Exec(`insert into datasets (id, report_id, query_id) values ($1, $2, $3)`, newUUID(), otherReportID, datasetQueryID)
+	require.NoError(t, err)
+	_, err = s.UpdateReport(userContext("author@example.com"), &proto.UpdateReportRequest{
+		Report: &proto.Report{Id: reportID, Title: "title", MapConfig: "{}"},
+		Query:  []*proto.Query{{Id: datasetQueryID, QueryText: "select 3"}},
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.UpdateReport(userContext("other@example.com"), &proto.UpdateReportRequest{
+		Report: &proto.Report{Id: otherReportID, Title: "title", MapConfig: "{}"},
+		Query:  []*proto.Query{{Id: datasetQueryID, QueryText: "select 3"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.QueryRow(`select query_source_id from queries where id=$1`, datasetQueryID).Scan(&storedSourceID))
+	require.Equal(t, querySourceID("select 3"), storedSourceID)
+}
@@ -0,0 +1,500 @@
+package dekart
+
+import (
+	"context"
+	"database/sql"
+	"dekart/src/proto"
+	"dekart/src/server/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// schedules are checked with this interval, cron expressions have minute precision
+const scheduleCheckInterval = 15 * time.Second
+
+const scheduleColumns = `
+	id,
+	report_id,
+	query_id,
+	cron,
+	enabled,
+	next_run_at,
+	last_run_at,
+	last_error,
+	created_at,
+	updated_at`
+
+// nextScheduleRun parses cron expression and returns next run time after now
+func nextScheduleRun(expression string) (time.Time, error) {
+	schedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(time.Now()), nil
+}
+
+func rowsToSchedules(rows *sql.Rows) ([]*proto.Schedule, error) {
+	schedules := make([]*proto.Schedule, 0)
+	for rows.Next() {
+		schedule := proto.Schedule{}
+		var queryID sql.NullString
+		var nextRunAt sql.NullTime
+		var lastRunAt sql.NullTime
+		var createdAt time.Time
+		var updatedAt time.Time
+		err := rows.Scan(
+			&schedule.Id,
+			&schedule.ReportId,
+			&queryID,
+			&schedule.Cron,
+			&schedule.Enabled,
+			&nextRunAt,
+			&lastRunAt,
+			&schedule.LastError,
+			&createdAt,
+			&updatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		schedule.QueryId = queryID.String
+		if nextRunAt.Valid {
+			schedule.NextRunAt = nextRunAt.Time.Unix()
+		}
+		if lastRunAt.Valid {
+			schedule.LastRunAt = lastRunAt.Time.Unix()
+		}
+		schedule.CreatedAt = createdAt.Unix()
+		schedule.UpdatedAt = updatedAt.Unix()
+		schedules = append(schedules, &schedule)
+	}
+	return schedules, rows.Err()
+}
+
+// isReportQuery checks if query belongs to report
+func (s Server) isReportQuery(ctx context.Context, reportID string, queryID string) (bool, error) {
+	var count int
+	err := s.db.QueryRowContext(ctx,
+		`select count(*) from queries
+			left join datasets on queries.id = datasets.query_id
+		where queries.id = $2 and (datasets.report_id = $1 or queries.report_id = $1)`,
+		reportID,
+		queryID,
+	).Scan(&count)
+	return count > 0, err
+}
+
+// getScheduleOfEditor returns schedule if user can edit its report
+func (s Server) getScheduleOfEditor(ctx context.Context, scheduleID string, email string) (*proto.Schedule, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`select `+scheduleColumns+` from schedules
+		where id=$1 and report_role(report_id, $2) >= $3`,
+		scheduleID,
+		email,
+		roleEditor,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	schedules, err := rowsToSchedules(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(schedules) == 0 {
+		return nil, nil
+	}
+	return schedules[0], nil
+}
+
+// GetSchedules of the report
+func (s Server) GetSchedules(ctx context.Context, req *proto.GetSchedulesRequest) (*proto.GetSchedulesResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	if err := s.checkCanWriteReport(ctx, req.ReportId, claims.Email); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(ctx,
+		`select `+scheduleColumns+` from schedules where report_id=$1 order by created_at`,
+		req.ReportId,
+	)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+	schedules, err := rowsToSchedules(rows)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &proto.GetSchedulesResponse{Schedules: schedules}, nil
+}
+
+// CreateSchedule for report or single query of the report
+func (s Server) CreateSchedule(ctx context.Context, req *proto.CreateScheduleRequest) (*proto.CreateScheduleResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	if err := s.checkCanWriteReport(ctx, req.ReportId, claims.Email); err != nil {
+		return nil, err
+	}
+	nextRunAt, err := nextScheduleRun(req.Cron)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	queryID := sql.NullString{String: req.QueryId, Valid: req.QueryId != ""}
+	if queryID.Valid {
+		_, err := uuid.Parse(req.QueryId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		isReportQuery, err := s.isReportQuery(ctx, req.ReportId, req.QueryId)
+		if err != nil {
+			log.Err(err).Send()
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if !isReportQuery {
+			err := fmt.Errorf("query %s not found in report %s", req.QueryId, req.ReportId)
+			log.Warn().Err(err).Send()
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+	}
+	id := newUUID()
+	_, err = s.db.ExecContext(ctx,
+		`insert into schedules (id, report_id, query_id, cron, author_email, next_run_at)
+		values ($1, $2, $3, $4, $5, $6)`,
+		id,
+		req.ReportId,
+		queryID,
+		req.Cron,
+		claims.Email,
+		nextRunAt,
+	)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	schedule, err := s.getScheduleOfEditor(ctx, id, claims.Email)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &proto.CreateScheduleResponse{Schedule: schedule}, nil
+}
+
+// UpdateSchedule cron expression and enabled flag; empty cron keeps expression of schedule
+func (s Server) UpdateSchedule(ctx context.Context, req *proto.UpdateScheduleRequest) (*proto.UpdateScheduleResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	_, err := uuid.Parse(req.ScheduleId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	expression := req.Cron
+	if expression == "" {
+		schedule, err := s.getScheduleOfEditor(ctx, req.ScheduleId, claims.Email)
+		if err != nil {
+			log.Err(err).Send()
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if schedule == nil {
+			err := fmt.Errorf("schedule not found id:%s", req.ScheduleId)
+			log.Warn().Err(err).Send()
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		expression = schedule.Cron
+	}
+	// next run is counted from now so re-enabled schedule does not run missed runs
+	nextRunAt, err := nextScheduleRun(expression)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	result, err := s.db.ExecContext(ctx,
+		`update schedules set
+			cron=$1,
+			enabled=$2,
+			next_run_at=$3,
+			updated_at=CURRENT_TIMESTAMP
+		where id=$4 and report_role(report_id, $5) >= $6`,
+		expression,
+		req.Enabled,
+		nextRunAt,
+		req.ScheduleId,
+		claims.Email,
+		roleEditor,
+	)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if affectedRows == 0 {
+		err := fmt.Errorf("schedule not found id:%s", req.ScheduleId)
+		log.Warn().Err(err).Send()
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	schedule, err := s.getScheduleOfEditor(ctx, req.ScheduleId, claims.Email)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &proto.UpdateScheduleResponse{Schedule: schedule}, nil
+}
+
+// DeleteSchedule implementation
+func (s Server) DeleteSchedule(ctx context.Context, req *proto.DeleteScheduleRequest) (*proto.DeleteScheduleResponse, error) {
+	claims := user.GetClaims(ctx)
+	if claims == nil {
+		return nil, Unauthenticated
+	}
+	_, err := uuid.Parse(req.ScheduleId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	result, err := s.db.ExecContext(ctx,
+		`delete from schedules
+		where id=$1 and report_role(report_id, $2) >= $3`,
+		req.ScheduleId,
+		claims.Email,
+		roleEditor,
+	)
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Err(err).Send()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if affectedRows == 0 {
+		err := fmt.Errorf("schedule not found id:%s", req.ScheduleId)
+		log.Warn().Err(err).Send()
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &proto.DeleteScheduleResponse{}, nil
+}
+
+type dueSchedule struct {
+	id          string
+	reportID    string
+	queryID     sql.NullString
+	authorEmail string // scheduled queries run as author of schedule
+}
+
+// RunSchedules refreshes queries of due schedules until ctx is done;
+// schedules are claimed with row locks so each run happens on one instance only
+func (s Server) RunSchedules(ctx context.Context) {
+	ticker := time.NewTicker(scheduleCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if s.isShuttingDown() {
+				// due schedules are left to other instances
+				continue
+			}
+			schedules, err := s.claimDueSchedules(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Err(err).Msg("Cannot claim due schedules")
+				}
+				continue
+			}
+			for _, schedule := range schedules {
+				s.runSchedule(ctx, schedule)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// claimDueSchedules moves next run of due schedules forward and returns them
+func (s Server) claimDueSchedules(ctx context.Context) ([]dueSchedule, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback(tx)
+	rows, err := tx.QueryContext(ctx,
+		`select schedules.id, schedules.report_id, schedules.query_id, schedules.author_email, schedules.cron
+		from schedules
+			join reports on reports.id = schedules.report_id
+		where schedules.enabled and not reports.archived and schedules.next_run_at <= CURRENT_TIMESTAMP
+		for update of schedules skip locked`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	schedules := make([]dueSchedule, 0)
+	expressions := make([]string, 0)
+	for rows.Next() {
+		var schedule dueSchedule
+		var expression string
+		if err := rows.Scan(&schedule.id, &schedule.reportID, &schedule.queryID, &schedule.authorEmail, &expression); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+		expressions = append(expressions, expression)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for i, schedule := range schedules {
+		nextRunAt, err := nextScheduleRun(expressions[i])
+		if err != nil {
+			// expressions are validated on save, disable schedule instead of retrying forever
+			_, err = tx.ExecContext(ctx,
+				`update schedules set enabled=false, last_error=$2 where id=$1`,
+				schedule.id,
+				err.Error(),
+			)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		_, err = tx.ExecContext(ctx,
+			`update schedules set next_run_at=$2, last_run_at=CURRENT_TIMESTAMP where id=$1`,
+			schedule.id,
+			nextRunAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// runSchedule runs scheduled queries which are not running already and stores errors in schedule
+func (s Server) runSchedule(ctx context.Context, schedule dueSchedule) {
+	logger := log.With().Str("scheduleID", schedule.id).Str("reportID", schedule.reportID).Logger()
+	errors := make([]string, 0)
+	queryIDs, err := s.getScheduledQueries(ctx, schedule)
+	if err != nil {
+		errors = append(errors, err.Error())
+	}
+	for _, queryID := range queryIDs {
+		err := s.runScheduledQuery(ctx, schedule.reportID, queryID, schedule.authorEmail)
+		if err != nil {
+			logger.Warn().Err(err).Str("queryID", queryID).Msg("Scheduled query failed to start")
+			errors = append(errors, fmt.Sprintf("query %s: %s", queryID, err))
+		}
+	}
+	_, err = s.db.ExecContext(ctx,
+		`update schedules set last_error=$2 where id=$1`,
+		schedule.id,
+		strings.Join(errors, "; "),
+	)
+	if err != nil {
+		logger.Err(err).Msg("Cannot update schedule")
+	}
+}
+
+// getScheduledQueries returns queries of schedule which are not running;
+// no queries are returned when author of schedule cannot edit report anymore
+func (s Server) getScheduledQueries(ctx context.Context, schedule dueSchedule) ([]string, error) {
+	var role int32
+	err := s.db.QueryRowContext(ctx,
+		`select report_role($1, $2)`,
+		schedule.reportID,
+		schedule.authorEmail,
+	).Scan(&role)
+	if err != nil {
+		return nil, err
+	}
+	if role < roleEditor {
+		return nil, fmt.Errorf("author %s of schedule cannot edit report", schedule.authorEmail)
+	}
+	rows, err := s.db.QueryContext(ctx,
+		`select queries.id from queries
+			left join datasets on queries.id = datasets.query_id
+		where (datasets.report_id = $1 or queries.report_id = $1)
+			and ($2::uuid is null or queries.id = $2)
+			and queries.job_status not in ($3, $4, $5)`,
+		schedule.reportID,
+		schedule.queryID,
+		int32(proto.Query_JOB_STATUS_PENDING),
+		int32(proto.Query_JOB_STATUS_RUNNING),
+		int32(proto.Query_JOB_STATUS_READING_RESULTS),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	queryIDs := make([]string, 0)
+	for rows.Next() {
+		var queryID string
+		if err := rows.Scan(&queryID); err != nil {
+			return nil, err
+		}
+		queryIDs = append(queryIDs, queryID)
+	}
+	return queryIDs, rows.Err()
+}
+
+// runScheduledQuery starts job with stored query text as author of schedule; cache is bypassed to get fresh data
+func (s Server) runScheduledQuery(ctx context.Context, reportID string, queryID string, authorEmail string) error {
+	var datasourceID string
+	err := s.db.QueryRowContext(ctx,
+		`select datasource_id from queries where id=$1`,
+		queryID,
+	).Scan(&datasourceID)
+	if err != nil {
+		return err
+	}
+	datasource, ok := s.datasources.Get(datasourceID)
+	if !ok {
+		return fmt.Errorf("datasource %s is not configured", datasourceID)
+	}
+	queryText, err := s.getQueryText(ctx, queryID)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(queryText) == "" {
+		return nil
+	}
+	// quotas and limits of schedule author apply, parameters have default values
+	err = s.checkQuota(ctx, authorEmail)
+	if err != nil {
+		return err
+	}
+	params, paramsHash, err := s.resolveParameters(ctx, reportID, queryText, nil, authorEmail)
+	if err != nil {
+		return err
+	}
+	err = s.checkQueryEstimate(ctx, authorEmail, datasource, queryText, params)
+	if err != nil {
+		return err
+	}
+	return s.runJob(ctx, reportID, queryID, queryText, datasource, jobRun{
+		userEmail:  authorEmail,
+		limits:     datasource.Limits,
+		params:     params,
+		paramsHash: paramsHash,
+	})
+}
@@ -0,0 +1,129 @@
+//go:build integration
+
+package dekart
+
+import (
+	"context"
+	"testing"
+
+	"dekart/src/proto"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// createDueSchedule of query by author which is due now
+func createDueSchedule(t *testing.T, s *Server, reportID string, queryID string, author string) string {
+	res, err := s.CreateSchedule(userContext(author), &proto.CreateScheduleRequest{
+		ReportId: reportID,
+		QueryId:  queryID,
+		Cron:     "0 * * * *",
+	})
+	require.NoError(t, err)
+	_, err = s.db.Exec(`update schedules set next_run_at=CURRENT_TIMESTAMP - interval '1 minute' where id=$1`, res.Schedule.Id)
+	require.NoError(t, err)
+	return res.Schedule.Id
+}
+
+func TestScheduleRunsAsAuthor(t *testing.T) {
+	db := newTestDB(t)
+	s, store := newTestServer(t, db)
+	ctx := context.Background()
+
+	reportID, queryID := createTestQuery(t, db, "author@example.com", "select 1")
+	scheduleID := createDueSchedule(t, s, reportID, queryID, "author@example.com")
+
+	schedules, err := s.claimDueSchedules(ctx)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	require.Equal(t, "author@example.com", schedules[0].authorEmail)
+	s.runSchedule(ctx, schedules[0])
+
+	require.Equal(t, 1, store.RunningJobs())
+	require.Equal(t, 1, countJobs(t, db, "query_id=$1 and user_email=$2", queryID, "author@example.com"))
+	var lastError string
+	require.NoError(t, db.QueryRow(`select last_error from schedules where id=$1`, scheduleID).Scan(&lastError))
+	require.Empty(t, lastError)
+}
+
+func TestScheduleQuotaOfAuthor(t *testing.T) {
+	db := newTestDB(t)
+	s, store := newTestServer(t, db)
+	s.quotas.maxUserJobs = 1
+	ctx := context.Background()
+
+	reportID, queryID := createTestQuery(t, db, "author@example.com", "select 1")
+	scheduleID := createDueSchedule(t, s, reportID, queryID, "author@example.com")
+	// author already runs a query
+	_, runningQueryID := createTestQuery(t, db, "author@example.com", "select 2")
+	insertTrackedJob(t, db, reportID, runningQueryID, "running", false, false)
+	_, err := db.Exec(`update jobs set user_email=$1`, "author@example.com")
+	require.NoError(t, err)
+
+	schedules, err := s.claimDueSchedules(ctx)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	s.runSchedule(ctx, schedules[0])
+
+	require.Equal(t, 0, store.RunningJobs())
+	var lastError string
+	require.NoError(t, db.QueryRow(`select last_error from schedules where id=$1`, scheduleID).Scan(&lastError))
+	require.Contains(t, lastError, "running queries")
+}
+
+func TestScheduleAuthorWithoutAccess(t *testing.T) {
+	db := newTestDB(t)
+	s, store := newTestServer(t, db)
+	ctx := context.Background()
+
+	reportID, queryID := createTestQuery(t, db, "author@example.com", "select 1")
+	scheduleID := createDueSchedule(t, s, reportID, queryID, "author@example.com")
+	_, err := db.Exec(`update schedules set author_email=$1 where id=$2`, "former@example.com", scheduleID)
+	require.NoError(t, err)
+
+	schedules, err := s.claimDueSchedules(ctx)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	s.runSchedule(ctx, schedules[0])
+
+	require.Equal(t, 0, store.RunningJobs())
+	var lastError string
+	require.NoError(t, db.QueryRow(`select last_error from schedules where id=$1`, scheduleID).Scan(&lastError))
+	require.Contains(t, lastError, "cannot edit report")
+}
+
+func TestUpdateSchedule(t *testing.T) {
+	db := newTestDB(t)
+	s, _ := newTestServer(t, db)
+	ctx := userContext("author@example.com")
+
+	reportID, queryID := createTestQuery(t, db, "author@example.com", "select 1")
+	created, err := s.CreateSchedule(ctx, &proto.CreateScheduleRequest{
+		ReportId: reportID,
+		QueryId:  queryID,
+		Cron:     "0 * * * *",
+	})
+	require.NoError(t, err)
+	require.True(t, created.Schedule.Enabled)
+
+	// enabled flag is toggled without cron
+	updated, err := s.UpdateSchedule(ctx, &proto.UpdateScheduleRequest{ScheduleId: created.Schedule.Id})
+	require.NoError(t, err)
+	require.False(t, updated.Schedule.Enabled)
+	require.Equal(t, "0 * * * *", updated.Schedule.Cron)
+
+	updated, err = s.UpdateSchedule(ctx, &proto.UpdateScheduleRequest{ScheduleId: created.Schedule.Id, Cron: "30 6 * * *", Enabled: true})
+	require.NoError(t, err)
+	require.True(t, updated.Schedule.Enabled)
+	require.Equal(t, "30 6 * * *", updated.Schedule.Cron)
+
+	_, err = s.UpdateSchedule(ctx, &proto.UpdateScheduleRequest{ScheduleId: created.Schedule.Id, Cron: "not cron"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// only editors of report can update schedule
+	_, err = s.UpdateSchedule(userContext("other@example.com"), &proto.UpdateScheduleRequest{ScheduleId: created.Schedule.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.UpdateSchedule(userContext("other@example.com"), &proto.UpdateScheduleRequest{ScheduleId: created.Schedule.Id, Cron: "0 * * * *"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
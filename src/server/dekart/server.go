@@ -8,6 +8,7 @@ import (
 	"dekart/src/server/report"
 	"dekart/src/server/storage"
 	"os"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -19,28 +20,39 @@ type Server struct {
 	reportStreams *report.Streams
 	storage       storage.Storage
 	proto.UnimplementedDekartServer
-	jobs job.Store
+	datasources *job.Datasources
+	instanceID  string
+	// results of the same query text are reused within TTL
+	queryCacheTTL time.Duration
+	// queries estimated to scan more bytes are refused
+	estimateLimits estimateLimits
+	quotas         quotas
+	health         *health
 }
 
 //Unauthenticated error returned when no user claims in context
 var Unauthenticated error = status.Error(codes.Unauthenticated, "UNAUTHENTICATED")
 
 // NewServer returns new Dekart Server
-// func NewServer(db *sql.DB, bucket *storage.BucketHandle, jobs *job.Store) *Server {
-func NewServer(db *sql.DB, storageBucket storage.Storage, jobs job.Store) *Server {
+func NewServer(db *sql.DB, storageBucket storage.Storage, datasources *job.Datasources) *Server {
 	server := Server{
-		db:            db,
-		reportStreams: report.NewStreams(),
-		storage:       storageBucket,
-		jobs:          jobs,
+		db:             db,
+		reportStreams:  report.NewStreams(),
+		storage:        storageBucket,
+		datasources:    datasources,
+		instanceID:     newUUID(),
+		queryCacheTTL:  getQueryCacheTTL(),
+		estimateLimits: getEstimateLimits(),
+		quotas:         getQuotas(),
+		health:         &health{},
 	}
 	return &server
 
 }
 
-// Shutdown cancels all jobs
-func (s Server) Shutdown(ctx context.Context) {
-	s.jobs.CancelAll(ctx)
+// ListenReportStreams shares report updates with other server instances until ctx is done
+func (s Server) ListenReportStreams(ctx context.Context, notifier report.Notifier) error {
+	return s.reportStreams.Listen(ctx, notifier)
 }
 
 func defaultString(s, def string) string {
@@ -75,7 +87,7 @@ func (s Server) GetEnv(ctx context.Context, req *proto.GetEnvRequest) (*proto.Ge
 		},
 		{
 			Type:  proto.GetEnvResponse_Variable_TYPE_DATASOURCE,
-			Value: defaultString(os.Getenv("DEKART_DATASOURCE"), "BQ"),
+			Value: s.datasources.Default().Type,
 		},
 		{
 			Type:  proto.GetEnvResponse_Variable_TYPE_STORAGE,
@@ -93,8 +105,20 @@ func (s Server) GetEnv(ctx context.Context, req *proto.GetEnvRequest) (*proto.Ge
 			Type:  proto.GetEnvResponse_Variable_TYPE_DISABLE_USAGE_STATS,
 			Value: defaultString(os.Getenv("DEKART_DISABLE_USAGE_STATS"), ""),
 		},
+		{
+			Type:  proto.GetEnvResponse_Variable_TYPE_REQUIRE_OIDC,
+			Value: defaultString(os.Getenv("DEKART_REQUIRE_OIDC"), ""),
+		},
+	}
+	datasources := make([]*proto.Datasource, 0)
+	for _, datasource := range s.datasources.List() {
+		datasources = append(datasources, &proto.Datasource{
+			Id:   datasource.ID,
+			Type: datasource.Type,
+		})
 	}
 	return &proto.GetEnvResponse{
-		Variables: variables,
+		Variables:   variables,
+		Datasources: datasources,
 	}, nil
 }
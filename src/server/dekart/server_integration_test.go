@@ -0,0 +1,164 @@
+//go:build integration
+
+package dekart
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"dekart/src/proto"
+	"dekart/src/server/job"
+	"dekart/src/server/storage"
+	"dekart/src/server/user"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/lib/pq"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDB returns database with migrations applied in new schema, schema is dropped after test;
+// tests are skipped when DEKART_TEST_POSTGRES_URL is not set
+func newTestDB(t *testing.T) *sql.DB {
+	postgresURL := os.Getenv("DEKART_TEST_POSTGRES_URL")
+	if postgresURL == "" {
+		t.Skip("DEKART_TEST_POSTGRES_URL is not set")
+	}
+	admin, err := sql.Open("postgres", postgresURL)
+	require.NoError(t, err)
+	t.Cleanup(func() { admin.Close() })
+	schema := "test_" + strings.ReplaceAll(newUUID(), "-", "")
+	_, err = admin.Exec(fmt.Sprintf("create schema %s", schema))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := admin.Exec(fmt.Sprintf("drop schema %s cascade", schema))
+		require.NoError(t, err)
+	})
+
+	u, err := url.Parse(postgresURL)
+	require.NoError(t, err)
+	query := u.Query()
+	query.Set("search_path", schema)
+	u.RawQuery = query.Encode()
+	db, err := sql.Open("postgres", u.String())
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	driver, err := postgres.WithInstance(db, &postgres.Config{})
+	require.NoError(t, err)
+	m, err := migrate.NewWithDatabaseInstance("file://../../../migrations", "postgres", driver)
+	require.NoError(t, err)
+	require.NoError(t, m.Up())
+	return db
+}
+
+// newTestServer with local storage and test datasource
+func newTestServer(t *testing.T, db *sql.DB) (*Server, *testStore) {
+	bucket, err := storage.NewLocalStorageInDir(t.TempDir())
+	require.NoError(t, err)
+	store := &testStore{}
+	datasources := job.NewDatasources()
+	datasources.Register("test", "TEST", store, job.Limits{})
+	s := NewServer(db, bucket, datasources)
+	t.Cleanup(func() { store.CancelAll(context.Background()) })
+	return s, store
+}
+
+// userContext with claims of user
+func userContext(email string) context.Context {
+	r := httptest.NewRequest("GET", "/", nil)
+	return user.NewClaimsCheck("", false, false, "", email).GetContext(r)
+}
+
+// createTestQuery in new report of author
+func createTestQuery(t *testing.T, db *sql.DB, author string, queryText string) (string, string) {
+	reportID := newUUID()
+	queryID := newUUID()
+	_, err := db.Exec(`insert into reports (id, author_email) values ($1, $2)`, reportID, author)
+	require.NoError(t, err)
+	_, err = db.Exec(`insert into queries (id, report_id, query_text) values ($1, $2, $3)`, queryID, reportID, queryText)
+	require.NoError(t, err)
+	return reportID, queryID
+}
+
+// queryStatus of query in database
+func queryStatus(t *testing.T, db *sql.DB, queryID string) (int32, string) {
+	var jobStatus int32
+	var jobError sql.NullString
+	err := db.QueryRow(`select job_status, job_error from queries where id=$1`, queryID).Scan(&jobStatus, &jobError)
+	require.NoError(t, err)
+	return jobStatus, jobError.String
+}
+
+// waitFor condition checked every 10ms up to 5s
+func waitFor(t *testing.T, condition func() bool) {
+	require.Eventually(t, condition, 5*time.Second, 10*time.Millisecond)
+}
+
+// testJob runs until cancelled
+type testJob struct {
+	job.BasicJob
+}
+
+func (j *testJob) Run(storageObject storage.StorageObject) error {
+	j.Lock()
+	if j.ExternalID == "" {
+		j.ExternalID = "external-" + j.GetID()
+	}
+	j.Unlock()
+	go func() {
+		select {
+		case j.Status() <- int32(proto.Query_JOB_STATUS_RUNNING):
+		case <-j.GetCtx().Done():
+		}
+	}()
+	return nil
+}
+
+// testStore of jobs which run until cancelled, jobs can be resumed
+type testStore struct {
+	job.BasicStore
+	resumed []string // external ids of resumed jobs
+}
+
+func (s *testStore) newJob(reportID string, queryID string, queryText string, externalID string) (job.Job, chan int32, error) {
+	j := &testJob{
+		BasicJob: job.BasicJob{
+			ReportID:   reportID,
+			QueryID:    queryID,
+			QueryText:  queryText,
+			ExternalID: externalID,
+			Logger:     zerolog.Nop(),
+		},
+	}
+	j.Init()
+	s.StoreJob(j)
+	go s.RemoveJobWhenDone(j)
+	return j, j.Status(), nil
+}
+
+func (s *testStore) Create(reportID string, queryID string, queryText string) (job.Job, chan int32, error) {
+	return s.newJob(reportID, queryID, queryText, "")
+}
+
+func (s *testStore) Resume(reportID string, queryID string, queryText string, externalID string) (job.Job, chan int32, error) {
+	s.Lock()
+	s.resumed = append(s.resumed, externalID)
+	s.Unlock()
+	return s.newJob(reportID, queryID, queryText, externalID)
+}
+
+func (s *testStore) getResumed() []string {
+	s.Lock()
+	defer s.Unlock()
+	return append([]string{}, s.resumed...)
+}
@@ -115,13 +115,15 @@ func (s Server) sendReportList(ctx context.Context, srv proto.Dekart_GetReportLi
 			id,
 			case when title is null then 'Untitled' else title end as title,
 			archived,
-			author_email = $1 as can_write,
+			report_role(id, $1) >= $2 as can_write,
+			author_email = $1 as is_author,
 			author_email,
 			discoverable
 		from reports
-		where author_email=$1 or (discoverable=true and archived=false)
+		where author_email=$1 or (archived=false and (discoverable=true or report_role(id, $1) > 0))
 		order by updated_at desc`,
 		claims.Email,
+		roleEditor,
 	)
 	if err != nil {
 		log.Err(err).Send()
@@ -141,6 +143,7 @@ func (s Server) sendReportList(ctx context.Context, srv proto.Dekart_GetReportLi
 			&report.Title,
 			&report.Archived,
 			&report.CanWrite,
+			&report.IsAuthor,
 			&report.AuthorEmail,
 			&report.Discoverable,
 		)
@@ -0,0 +1,354 @@
+package dekart
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"dekart/src/server/metrics"
+	"dekart/src/server/storage"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+)
+
+// uploadChunkSize is size of all chunks but last; S3 requires at least 5 MiB parts
+const uploadChunkSize = 8 << 20
+
+type startUploadRequest struct {
+	Name     string `json:"name"`
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+}
+
+type uploadStatus struct {
+	ChunkSize      int64   `json:"chunkSize"`
+	ChunkCount     int32   `json:"chunkCount"`
+	UploadedChunks []int64 `json:"uploadedChunks"`
+}
+
+// fileUpload is state of resumable upload kept in files row
+type fileUpload struct {
+	name           string
+	size           int64
+	fileStatus     int32
+	fileSourceID   string
+	uploadID       string
+	uploadObject   string
+	chunkSize      int64
+	chunkCount     int32
+	uploadedChunks pq.Int64Array
+}
+
+func (u fileUpload) status() uploadStatus {
+	uploaded := []int64(u.uploadedChunks)
+	if uploaded == nil {
+		uploaded = []int64{}
+	}
+	return uploadStatus{
+		ChunkSize:      u.chunkSize,
+		ChunkCount:     u.chunkCount,
+		UploadedChunks: uploaded,
+	}
+}
+
+// chunkLength is expected length of chunk, last chunk is shorter
+func (u fileUpload) chunkLength(chunk int64) int64 {
+	if rest := u.size - chunk*u.chunkSize; rest < u.chunkSize {
+		return rest
+	}
+	return u.chunkSize
+}
+
+func (s Server) getFileUpload(ctx context.Context, fileID string) (*fileUpload, error) {
+	u := &fileUpload{}
+	var name, fileSourceID, uploadID, uploadObject sql.NullString
+	err := s.db.QueryRowContext(ctx,
+		`select name, size, file_status, file_source_id, upload_id, upload_object, chunk_size, chunk_count, uploaded_chunks
+		from files where id=$1`,
+		fileID,
+	).Scan(&name, &u.size, &u.fileStatus, &fileSourceID, &uploadID, &uploadObject, &u.chunkSize, &u.chunkCount, &u.uploadedChunks)
+	if err != nil {
+		return nil, err
+	}
+	u.name = name.String
+	u.fileSourceID = fileSourceID.String
+	u.uploadID = uploadID.String
+	u.uploadObject = uploadObject.String
+	return u, nil
+}
+
+func writeUploadStatus(w http.ResponseWriter, u *fileUpload) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(u.status()); err != nil {
+		log.Err(err).Send()
+	}
+}
+
+// StartFileUpload starts resumable upload or returns progress of upload of the same file started before
+func (s Server) StartFileUpload(w http.ResponseWriter, r *http.Request) {
+	reportIDs, ok := s.getUploadFileReports(w, r)
+	if !ok {
+		return
+	}
+	fileID := mux.Vars(r)["id"]
+	ctx := r.Context()
+	var req startUploadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Warn().Err(err).Send()
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	fileExtension := getFileExtension(req.MimeType, req.Name)
+	if fileExtension == "" || req.Size <= 0 {
+		err := fmt.Errorf("unsupported file type or empty file")
+		log.Warn().Err(err).Str("mimeType", req.MimeType).Send()
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	current, err := s.getFileUpload(ctx, fileID)
+	if err != nil {
+		log.Err(err).Send()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if current.fileStatus > 1 {
+		err := fmt.Errorf("file is already uploaded")
+		log.Warn().Err(err).Str("fileID", fileID).Send()
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if current.uploadID != "" {
+		if current.name == req.Name && current.size == req.Size {
+			// the same file is uploaded again after disconnect
+			writeUploadStatus(w, current)
+			return
+		}
+		// another file was selected, upload of previous one is abandoned
+		err = storage.GetMultipartUpload(s.storage, current.uploadObject, current.uploadID).Abort(ctx, int(current.chunkCount))
+		if err != nil {
+			log.Warn().Err(err).Str("fileID", fileID).Msg("error aborting previous upload")
+		}
+	}
+
+	fileSourceID := newUUID()
+	uploadObject := fmt.Sprintf("%s.%s", fileSourceID, fileExtension)
+	uploadID, err := storage.CreateMultipartUpload(ctx, s.storage, uploadObject)
+	if err != nil {
+		log.Err(err).Send()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	upload := &fileUpload{
+		name:         req.Name,
+		size:         req.Size,
+		fileSourceID: fileSourceID,
+		uploadID:     uploadID,
+		uploadObject: uploadObject,
+		chunkSize:    uploadChunkSize,
+		chunkCount:   int32((req.Size + uploadChunkSize - 1) / uploadChunkSize),
+	}
+	_, err = s.db.ExecContext(ctx,
+		`update files set
+			name=$1, size=$2, mime_type=$3, file_source_id=$4, upload_id=$5, upload_object=$6,
+			chunk_size=$7, chunk_count=$8, uploaded_chunks='{}', upload_error=''
+		where id=$9 and file_status=1`,
+		upload.name,
+		upload.size,
+		getStoredMimeType(fileExtension),
+		upload.fileSourceID,
+		upload.uploadID,
+		upload.uploadObject,
+		upload.chunkSize,
+		upload.chunkCount,
+		fileID,
+	)
+	if err != nil {
+		log.Err(err).Send()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.reportStreams.PingAll(reportIDs)
+	writeUploadStatus(w, upload)
+}
+
+// UploadFileChunk stores chunk of resumable upload, chunks are numbered from 0 and may be uploaded in any order
+func (s Server) UploadFileChunk(w http.ResponseWriter, r *http.Request) {
+	_, ok := s.getUploadFileReports(w, r)
+	if !ok {
+		return
+	}
+	fileID := mux.Vars(r)["id"]
+	ctx := r.Context()
+	chunk, err := strconv.ParseInt(mux.Vars(r)["chunk"], 10, 32)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	upload, err := s.getFileUpload(ctx, fileID)
+	if err != nil {
+		log.Err(err).Send()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if upload.uploadID == "" || upload.fileStatus != 1 {
+		err := fmt.Errorf("upload is not started")
+		log.Warn().Err(err).Str("fileID", fileID).Send()
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if chunk < 0 || chunk >= int64(upload.chunkCount) {
+		err := fmt.Errorf("chunk %d out of range", chunk)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	expected := upload.chunkLength(chunk)
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, expected))
+	if err != nil || int64(len(body)) != expected {
+		err := fmt.Errorf("chunk %d must be %d bytes", chunk, expected)
+		log.Warn().Err(err).Str("fileID", fileID).Send()
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	err = storage.GetMultipartUpload(s.storage, upload.uploadObject, upload.uploadID).UploadPart(ctx, int(chunk)+1, bytes.NewReader(body))
+	if err != nil {
+		log.Err(err).Send()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	err = s.db.QueryRowContext(ctx,
+		`update files set uploaded_chunks = case
+			when $1 = any(uploaded_chunks) then uploaded_chunks
+			else array_append(uploaded_chunks, $1)
+		end
+		where id=$2 and upload_id=$3
+		returning uploaded_chunks`,
+		chunk,
+		fileID,
+		upload.uploadID,
+	).Scan(&upload.uploadedChunks)
+	if err != nil {
+		log.Err(err).Send()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeUploadStatus(w, upload)
+}
+
+// CompleteFileUpload assembles uploaded chunks and moves file to storage in background
+func (s Server) CompleteFileUpload(w http.ResponseWriter, r *http.Request) {
+	reportIDs, ok := s.getUploadFileReports(w, r)
+	if !ok {
+		return
+	}
+	fileID := mux.Vars(r)["id"]
+	ctx := r.Context()
+	upload, err := s.getFileUpload(ctx, fileID)
+	if err != nil {
+		log.Err(err).Send()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if upload.uploadID == "" || upload.fileStatus != 1 {
+		err := fmt.Errorf("upload is not started")
+		log.Warn().Err(err).Str("fileID", fileID).Send()
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if len(upload.uploadedChunks) != int(upload.chunkCount) {
+		err := fmt.Errorf("uploaded %d of %d chunks", len(upload.uploadedChunks), upload.chunkCount)
+		log.Warn().Err(err).Str("fileID", fileID).Send()
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	result, err := s.db.ExecContext(ctx,
+		`update files set file_status=2 where id=$1 and upload_id=$2 and file_status=1`,
+		fileID,
+		upload.uploadID,
+	)
+	if err != nil {
+		log.Err(err).Send()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Err(err).Send()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if affectedRows == 0 {
+		http.Error(w, "upload is already completed", http.StatusConflict)
+		return
+	}
+	go s.completeFileUpload(upload, reportIDs)
+	s.reportStreams.PingAll(reportIDs)
+	writeUploadStatus(w, upload)
+}
+
+// completeFileUpload assembles object from chunks and converts it when needed
+func (s Server) completeFileUpload(upload *fileUpload, reportIDs []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	var err error
+	start := time.Now()
+	defer func() { metrics.ObserveUpload("chunked", start, err) }()
+	err = storage.GetMultipartUpload(s.storage, upload.uploadObject, upload.uploadID).Complete(ctx, int(upload.chunkCount))
+	if err != nil {
+		log.Err(err).Send()
+		s.setUploadError(reportIDs, upload.fileSourceID, err)
+		return
+	}
+	fileExtension := path.Ext(upload.uploadObject)[1:]
+	if getStoredExtension(fileExtension) != fileExtension {
+		err = s.convertUploadedObject(ctx, upload, fileExtension)
+		if err != nil {
+			log.Err(err).Send()
+			s.setUploadError(reportIDs, upload.fileSourceID, err)
+			return
+		}
+	}
+	log.Debug().Msgf("file %s.%s uploaded to storage", upload.fileSourceID, getStoredExtension(fileExtension))
+	s.setFileStored(ctx, upload.fileSourceID, reportIDs)
+}
+
+// convertUploadedObject copies assembled object to temp file, because converters need random access
+func (s Server) convertUploadedObject(ctx context.Context, upload *fileUpload, fileExtension string) error {
+	obj := s.storage.GetObject(upload.uploadObject)
+	reader, err := obj.GetReader(ctx)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	tmp, err := ioutil.TempFile("", "dekart-upload-*."+fileExtension)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	size, err := io.Copy(tmp, reader)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	err = s.convertFileToStorage(ctx, upload.fileSourceID, fileExtension, tmp, size)
+	if err != nil {
+		return err
+	}
+	// original file is not needed after conversion
+	if err := obj.Delete(ctx); err != nil {
+		log.Warn().Err(err).Str("object", upload.uploadObject).Msg("error deleting uploaded file")
+	}
+	return nil
+}
@@ -0,0 +1,425 @@
+package geom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Geometry types as named in WKT and GeoJSON
+const (
+	Point              = "Point"
+	LineString         = "LineString"
+	Polygon            = "Polygon"
+	MultiPoint         = "MultiPoint"
+	MultiLineString    = "MultiLineString"
+	MultiPolygon       = "MultiPolygon"
+	GeometryCollection = "GeometryCollection"
+)
+
+var wkbTypes = map[uint32]string{
+	1: Point,
+	2: LineString,
+	3: Polygon,
+	4: MultiPoint,
+	5: MultiLineString,
+	6: MultiPolygon,
+	7: GeometryCollection,
+}
+
+// EWKB flags used by PostGIS
+const (
+	ewkbZ    = 0x80000000
+	ewkbM    = 0x40000000
+	ewkbSRID = 0x20000000
+)
+
+// Coord is x, y and optional z; measures are dropped
+type Coord []float64
+
+// Geometry decoded from WKB; only one of the coordinate fields is set depending on Type
+type Geometry struct {
+	Type       string
+	SRID       int
+	HasZ       bool
+	Point      Coord       // Point; nil means empty point
+	Line       []Coord     // LineString, MultiPoint
+	Rings      [][]Coord   // Polygon, MultiLineString
+	Polygons   [][][]Coord // MultiPolygon
+	Geometries []*Geometry // GeometryCollection
+}
+
+type wkbReader struct {
+	r     *bytes.Reader
+	order binary.ByteOrder
+}
+
+// ParseHexWKB decodes hex encoded WKB or PostGIS EWKB, as returned by Postgres for geometry columns
+func ParseHexWKB(s string) (*Geometry, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWKB(b)
+}
+
+// ParseWKB decodes ISO WKB or PostGIS EWKB
+func ParseWKB(b []byte) (*Geometry, error) {
+	r := &wkbReader{r: bytes.NewReader(b)}
+	g, err := r.readGeometry()
+	if err != nil {
+		return nil, err
+	}
+	if r.r.Len() > 0 {
+		return nil, fmt.Errorf("unexpected %d trailing bytes in WKB", r.r.Len())
+	}
+	return g, nil
+}
+
+func (r *wkbReader) readUint32() (uint32, error) {
+	var v uint32
+	err := binary.Read(r.r, r.order, &v)
+	return v, err
+}
+
+func (r *wkbReader) readCoord(dims int, hasZ bool) (Coord, error) {
+	values := make([]float64, dims)
+	if err := binary.Read(r.r, r.order, values); err != nil {
+		return nil, err
+	}
+	if hasZ {
+		return Coord(values[:3]), nil
+	}
+	return Coord(values[:2]), nil
+}
+
+func (r *wkbReader) readCoords(dims int, hasZ bool) ([]Coord, error) {
+	n, err := r.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	// each coordinate takes at least 16 bytes, protects from allocating huge slices
+	if int(n) > r.r.Len()/16 {
+		return nil, fmt.Errorf("invalid number of points %d in WKB", n)
+	}
+	coords := make([]Coord, n)
+	for i := range coords {
+		if coords[i], err = r.readCoord(dims, hasZ); err != nil {
+			return nil, err
+		}
+	}
+	return coords, nil
+}
+
+func (r *wkbReader) readRings(dims int, hasZ bool) ([][]Coord, error) {
+	n, err := r.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	if int(n) > r.r.Len()/4 {
+		return nil, fmt.Errorf("invalid number of rings %d in WKB", n)
+	}
+	rings := make([][]Coord, n)
+	for i := range rings {
+		if rings[i], err = r.readCoords(dims, hasZ); err != nil {
+			return nil, err
+		}
+	}
+	return rings, nil
+}
+
+func (r *wkbReader) readGeometry() (*Geometry, error) {
+	byteOrder, err := r.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	switch byteOrder {
+	case 0:
+		r.order = binary.BigEndian
+	case 1:
+		r.order = binary.LittleEndian
+	default:
+		return nil, fmt.Errorf("invalid WKB byte order %d", byteOrder)
+	}
+	typeCode, err := r.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	g := &Geometry{}
+	if typeCode&ewkbSRID != 0 {
+		srid, err := r.readUint32()
+		if err != nil {
+			return nil, err
+		}
+		g.SRID = int(srid)
+	}
+	g.HasZ = typeCode&ewkbZ != 0
+	hasM := typeCode&ewkbM != 0
+	typeCode = typeCode &^ (ewkbZ | ewkbM | ewkbSRID)
+	// ISO WKB encodes dimensions as thousands
+	switch typeCode / 1000 {
+	case 1:
+		g.HasZ = true
+	case 2:
+		hasM = true
+	case 3:
+		g.HasZ = true
+		hasM = true
+	}
+	geometryType, ok := wkbTypes[typeCode%1000]
+	if !ok {
+		return nil, fmt.Errorf("unsupported WKB geometry type %d", typeCode)
+	}
+	g.Type = geometryType
+	dims := 2
+	if g.HasZ {
+		dims++
+	}
+	if hasM {
+		dims++
+	}
+
+	switch g.Type {
+	case Point:
+		coord, err := r.readCoord(dims, g.HasZ)
+		if err != nil {
+			return nil, err
+		}
+		if !math.IsNaN(coord[0]) {
+			g.Point = coord
+		}
+	case LineString:
+		g.Line, err = r.readCoords(dims, g.HasZ)
+	case Polygon:
+		g.Rings, err = r.readRings(dims, g.HasZ)
+	case MultiPoint, MultiLineString, MultiPolygon, GeometryCollection:
+		err = r.readCollection(g)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (r *wkbReader) readCollection(g *Geometry) error {
+	n, err := r.readUint32()
+	if err != nil {
+		return err
+	}
+	if int(n) > r.r.Len()/5 {
+		return fmt.Errorf("invalid number of geometries %d in WKB", n)
+	}
+	for i := 0; i < int(n); i++ {
+		// nested geometries have their own header and may use another byte order
+		nested := &wkbReader{r: r.r}
+		part, err := nested.readGeometry()
+		if err != nil {
+			return err
+		}
+		switch g.Type {
+		case MultiPoint:
+			if part.Type != Point {
+				return fmt.Errorf("unexpected %s in %s", part.Type, g.Type)
+			}
+			if part.Point != nil {
+				g.Line = append(g.Line, part.Point)
+			}
+		case MultiLineString:
+			if part.Type != LineString {
+				return fmt.Errorf("unexpected %s in %s", part.Type, g.Type)
+			}
+			g.Rings = append(g.Rings, part.Line)
+		case MultiPolygon:
+			if part.Type != Polygon {
+				return fmt.Errorf("unexpected %s in %s", part.Type, g.Type)
+			}
+			g.Polygons = append(g.Polygons, part.Rings)
+		default:
+			g.Geometries = append(g.Geometries, part)
+		}
+	}
+	return nil
+}
+
+func formatCoord(c Coord) string {
+	values := make([]string, len(c))
+	for i, v := range c {
+		values[i] = strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return strings.Join(values, " ")
+}
+
+func formatCoords(coords []Coord) string {
+	values := make([]string, len(coords))
+	for i, c := range coords {
+		values[i] = formatCoord(c)
+	}
+	return "(" + strings.Join(values, ", ") + ")"
+}
+
+func formatRings(rings [][]Coord) string {
+	values := make([]string, len(rings))
+	for i, ring := range rings {
+		values[i] = formatCoords(ring)
+	}
+	return "(" + strings.Join(values, ", ") + ")"
+}
+
+func (g *Geometry) isEmpty() bool {
+	switch g.Type {
+	case Point:
+		return g.Point == nil
+	case LineString, MultiPoint:
+		return len(g.Line) == 0
+	case Polygon, MultiLineString:
+		return len(g.Rings) == 0
+	case MultiPolygon:
+		return len(g.Polygons) == 0
+	default:
+		return len(g.Geometries) == 0
+	}
+}
+
+// WKT representation of geometry
+func (g *Geometry) WKT() string {
+	name := strings.ToUpper(g.Type)
+	if g.HasZ {
+		name += " Z"
+	}
+	if g.isEmpty() {
+		return name + " EMPTY"
+	}
+	switch g.Type {
+	case Point:
+		return name + " (" + formatCoord(g.Point) + ")"
+	case LineString:
+		return name + " " + formatCoords(g.Line)
+	case MultiPoint:
+		points := make([]string, len(g.Line))
+		for i, c := range g.Line {
+			points[i] = "(" + formatCoord(c) + ")"
+		}
+		return name + " (" + strings.Join(points, ", ") + ")"
+	case Polygon, MultiLineString:
+		return name + " " + formatRings(g.Rings)
+	case MultiPolygon:
+		polygons := make([]string, len(g.Polygons))
+		for i, rings := range g.Polygons {
+			polygons[i] = formatRings(rings)
+		}
+		return name + " (" + strings.Join(polygons, ", ") + ")"
+	default:
+		geometries := make([]string, len(g.Geometries))
+		for i, part := range g.Geometries {
+			geometries[i] = part.WKT()
+		}
+		return name + " (" + strings.Join(geometries, ", ") + ")"
+	}
+}
+
+type geoJSONGeometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+type geoJSONCollection struct {
+	Type       string      `json:"type"`
+	Geometries []*Geometry `json:"geometries"`
+}
+
+// MarshalJSON encodes geometry as GeoJSON geometry object
+func (g *Geometry) MarshalJSON() ([]byte, error) {
+	res := geoJSONGeometry{Type: g.Type}
+	switch g.Type {
+	case Point:
+		if g.Point == nil {
+			res.Coordinates = []float64{}
+		} else {
+			res.Coordinates = g.Point
+		}
+	case LineString, MultiPoint:
+		res.Coordinates = nonNilCoords(g.Line)
+	case Polygon, MultiLineString:
+		res.Coordinates = nonNilRings(g.Rings)
+	case MultiPolygon:
+		if g.Polygons == nil {
+			res.Coordinates = [][][]Coord{}
+		} else {
+			res.Coordinates = g.Polygons
+		}
+	default:
+		geometries := g.Geometries
+		if geometries == nil {
+			geometries = []*Geometry{}
+		}
+		return json.Marshal(geoJSONCollection{Type: g.Type, Geometries: geometries})
+	}
+	return json.Marshal(res)
+}
+
+func nonNilCoords(coords []Coord) []Coord {
+	if coords == nil {
+		return []Coord{}
+	}
+	return coords
+}
+
+func nonNilRings(rings [][]Coord) [][]Coord {
+	if rings == nil {
+		return [][]Coord{}
+	}
+	return rings
+}
+
+// GeoJSON representation of geometry
+func (g *Geometry) GeoJSON() (string, error) {
+	b, err := json.Marshal(g)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Transform replaces every coordinate of geometry with result of f
+func (g *Geometry) Transform(f func(c Coord) (Coord, error)) error {
+	var err error
+	transformCoords := func(coords []Coord) error {
+		for i := range coords {
+			if coords[i], err = f(coords[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if g.Point != nil {
+		if g.Point, err = f(g.Point); err != nil {
+			return err
+		}
+	}
+	if err = transformCoords(g.Line); err != nil {
+		return err
+	}
+	for _, ring := range g.Rings {
+		if err = transformCoords(ring); err != nil {
+			return err
+		}
+	}
+	for _, polygon := range g.Polygons {
+		for _, ring := range polygon {
+			if err = transformCoords(ring); err != nil {
+				return err
+			}
+		}
+	}
+	for _, part := range g.Geometries {
+		if err = part.Transform(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
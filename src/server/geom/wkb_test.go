@@ -0,0 +1,115 @@
+package geom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+// wkb builds little endian WKB from type code and values
+func wkb(values ...interface{}) string {
+	buf := &bytes.Buffer{}
+	for _, v := range values {
+		switch x := v.(type) {
+		case int:
+			binary.Write(buf, binary.LittleEndian, uint32(x))
+		case float64:
+			binary.Write(buf, binary.LittleEndian, x)
+		case string:
+			b, _ := hex.DecodeString(x)
+			buf.Write(b)
+		}
+	}
+	return hex.EncodeToString(buf.Bytes())
+}
+
+func TestParseHexWKB(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		wkt     string
+		geojson string
+	}{
+		{
+			name:    "ewkb point with srid",
+			hex:     "0101000020E6100000000000000000F03F0000000000000040",
+			wkt:     "POINT (1 2)",
+			geojson: `{"type":"Point","coordinates":[1,2]}`,
+		},
+		{
+			name:    "big endian linestring",
+			hex:     "000000000200000002000000000000000000000000000000003FF00000000000003FF0000000000000",
+			wkt:     "LINESTRING (0 0, 1 1)",
+			geojson: `{"type":"LineString","coordinates":[[0,0],[1,1]]}`,
+		},
+		{
+			name:    "iso point z",
+			hex:     wkb("01", 1001, 1.5, 2.5, 3.0),
+			wkt:     "POINT Z (1.5 2.5 3)",
+			geojson: `{"type":"Point","coordinates":[1.5,2.5,3]}`,
+		},
+		{
+			name:    "ewkb point m drops measure",
+			hex:     wkb("01", 0x40000001, 1.0, 2.0, 9.0),
+			wkt:     "POINT (1 2)",
+			geojson: `{"type":"Point","coordinates":[1,2]}`,
+		},
+		{
+			name:    "polygon",
+			hex:     wkb("01", 3, 1, 4, 0.0, 0.0, 1.0, 0.0, 1.0, 1.0, 0.0, 0.0),
+			wkt:     "POLYGON ((0 0, 1 0, 1 1, 0 0))",
+			geojson: `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`,
+		},
+		{
+			name:    "multipolygon",
+			hex:     wkb("01", 6, 1, "01", 3, 1, 4, 0.0, 0.0, 1.0, 0.0, 1.0, 1.0, 0.0, 0.0),
+			wkt:     "MULTIPOLYGON (((0 0, 1 0, 1 1, 0 0)))",
+			geojson: `{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]]]}`,
+		},
+		{
+			name:    "multipoint",
+			hex:     wkb("01", 4, 2, "01", 1, 1.0, 2.0, "01", 1, 3.0, 4.0),
+			wkt:     "MULTIPOINT ((1 2), (3 4))",
+			geojson: `{"type":"MultiPoint","coordinates":[[1,2],[3,4]]}`,
+		},
+		{
+			name:    "geometry collection",
+			hex:     wkb("01", 7, 2, "01", 1, 1.0, 2.0, "01", 2, 2, 0.0, 0.0, 1.0, 1.0),
+			wkt:     "GEOMETRYCOLLECTION (POINT (1 2), LINESTRING (0 0, 1 1))",
+			geojson: `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1,2]},{"type":"LineString","coordinates":[[0,0],[1,1]]}]}`,
+		},
+		{
+			name:    "empty point",
+			hex:     "0101000000000000000000F87F000000000000F87F",
+			wkt:     "POINT EMPTY",
+			geojson: `{"type":"Point","coordinates":[]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := ParseHexWKB(tt.hex)
+			assert.NilError(t, err)
+			assert.Equal(t, g.WKT(), tt.wkt)
+			geojson, err := g.GeoJSON()
+			assert.NilError(t, err)
+			assert.Equal(t, geojson, tt.geojson)
+		})
+	}
+}
+
+func TestParseHexWKBErrors(t *testing.T) {
+	for _, s := range []string{
+		"not hex",
+		"",
+		"0201000000",
+		wkb("01", 99, 1.0, 2.0),
+		wkb("01", 2, 1000000, 0.0, 0.0),
+		wkb("01", 1, 1.0, 2.0, 3.0),
+	} {
+		_, err := ParseHexWKB(s)
+		assert.Assert(t, err != nil, s)
+	}
+}
@@ -0,0 +1,124 @@
+package job
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Config of a datasource; variable DEKART_DATASOURCE_<ID>_<NAME> overrides DEKART_<NAME>
+// so several datasources of the same type can be configured in one deployment
+type Config struct {
+	ID string
+}
+
+// Getenv returns datasource specific value of the environment variable
+func (c Config) Getenv(name string) string {
+	if c.ID != "" {
+		key := "DEKART_DATASOURCE_" + strings.ToUpper(c.ID) + "_" + strings.TrimPrefix(name, "DEKART_")
+		if value, ok := os.LookupEnv(key); ok {
+			return value
+		}
+	}
+	return os.Getenv(name)
+}
+
+// Datasource is a job store registered under ID
+type Datasource struct {
+	ID     string
+	Type   string
+	Store  Store
+	Limits Limits
+}
+
+// LimitsResult is false for Athena, result of query execution is copied as is
+func (d Datasource) LimitsResult() bool {
+	return d.Type != "ATHENA"
+}
+
+// Datasources routes queries to job stores; first registered datasource is the default one
+type Datasources struct {
+	sync.RWMutex
+	list []Datasource
+}
+
+// NewDatasources creates empty registry
+func NewDatasources() *Datasources {
+	return &Datasources{}
+}
+
+// Register job store under id; results of its jobs are written within limits
+func (d *Datasources) Register(id string, datasourceType string, store Store, limits Limits) {
+	d.Lock()
+	defer d.Unlock()
+	d.list = append(d.list, Datasource{
+		ID:     id,
+		Type:   datasourceType,
+		Store:  store,
+		Limits: limits,
+	})
+}
+
+// Default datasource used for queries without datasource id
+func (d *Datasources) Default() Datasource {
+	d.RLock()
+	defer d.RUnlock()
+	return d.list[0]
+}
+
+// Get datasource by id; empty id means default datasource. Queries created in single datasource mode
+// may store id which is lower case type, e.g. bq; when no datasource has such id the first one of the type is used
+func (d *Datasources) Get(id string) (Datasource, bool) {
+	if id == "" {
+		return d.Default(), true
+	}
+	d.RLock()
+	defer d.RUnlock()
+	for _, datasource := range d.list {
+		if datasource.ID == id {
+			return datasource, true
+		}
+	}
+	for _, datasource := range d.list {
+		if strings.ToLower(datasource.Type) == id {
+			return datasource, true
+		}
+	}
+	return Datasource{}, false
+}
+
+// StoredID of datasource in queries; default datasource is stored as empty id so queries
+// keep using default datasource when datasources are reconfigured
+func (d *Datasources) StoredID(id string) string {
+	if id == d.Default().ID {
+		return ""
+	}
+	return id
+}
+
+// List registered datasources
+func (d *Datasources) List() []Datasource {
+	d.RLock()
+	defer d.RUnlock()
+	list := make([]Datasource, len(d.list))
+	copy(list, d.list)
+	return list
+}
+
+// Cancel query job in any of the stores
+func (d *Datasources) Cancel(queryID string) bool {
+	for _, datasource := range d.List() {
+		if datasource.Store.Cancel(queryID) {
+			return true
+		}
+	}
+	return false
+}
+
+// CancelAll jobs in all stores
+func (d *Datasources) CancelAll(ctx context.Context) {
+	for _, datasource := range d.List() {
+		datasource.Store.CancelAll(ctx)
+	}
+}
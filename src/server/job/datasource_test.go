@@ -0,0 +1,32 @@
+package job
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatasources(t *testing.T) {
+	datasources := NewDatasources()
+	datasources.Register("warehouse", "BQ", nil, Limits{})
+	datasources.Register("gis", "PG", nil, Limits{})
+
+	datasource, ok := datasources.Get("")
+	require.True(t, ok)
+	require.Equal(t, "warehouse", datasource.ID)
+
+	datasource, ok = datasources.Get("gis")
+	require.True(t, ok)
+	require.Equal(t, "gis", datasource.ID)
+
+	// id stored by single datasource mode
+	datasource, ok = datasources.Get("pg")
+	require.True(t, ok)
+	require.Equal(t, "gis", datasource.ID)
+
+	_, ok = datasources.Get("athena")
+	require.False(t, ok)
+
+	require.Equal(t, "", datasources.StoredID("warehouse"))
+	require.Equal(t, "gis", datasources.StoredID("gis"))
+}
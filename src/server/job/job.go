@@ -5,12 +5,14 @@ import (
 	"dekart/src/proto"
 	"dekart/src/server/storage"
 	"dekart/src/server/uuid"
+	"fmt"
 	"regexp"
 	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Store is the interface for the job storage
@@ -18,6 +20,30 @@ type Store interface {
 	Create(reportID string, queryID string, queryText string) (Job, chan int32, error)
 	Cancel(queryID string) bool
 	CancelAll(ctx context.Context)
+	// StopAll jobs on this instance without changing their status, see Job.Stop
+	StopAll()
+	RunningJobs() int
+}
+
+// Resumer is implemented by stores which can re-attach to a job still running in the datasource,
+// e.g. after server restart; Run of the returned job waits for the existing job instead of starting new one
+type Resumer interface {
+	Resume(reportID string, queryID string, queryText string, externalID string) (Job, chan int32, error)
+}
+
+// Pinger is implemented by stores which can check connection to datasource without running a query
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Estimate of query cost made without running it
+type Estimate struct {
+	Bytes int64 // bytes query is expected to scan
+}
+
+// Estimator is implemented by stores which can estimate query with dry run or query plan
+type Estimator interface {
+	Estimate(ctx context.Context, queryText string, params []Parameter) (*Estimate, error)
 }
 
 // Job is the interface for the query job in datasource like BigQuery or Athena
@@ -29,11 +55,26 @@ type Job interface {
 	GetTotalRows() int64
 	GetProcessedBytes() int64
 	GetResultSize() int64
+	GetSchema() []Column   // columns of result, nil until known
+	GetExternalID() string // id of the job in datasource; empty until job is started
 	GetCtx() context.Context
 	Err() string
+	// SetLimits of job before it is started, timeout is counted from the moment limits are set
+	SetLimits(limits Limits)
+	GetLimits() Limits
+	GetTruncation() string // reason result was truncated, empty when result is complete
+	// SetParameters referenced in query text before job is started
+	SetParameters(params []Parameter)
+	GetParameters() []Parameter
+	// StartSpan of job as child of span in ctx before job is started, span ends when job is done
+	StartSpan(ctx context.Context)
 	Run(storageObject storage.StorageObject) error
 	Status() chan int32
 	Cancel()
+	// Stop job on this instance without sending status, so it can be resumed or run again by another instance;
+	// job is not cancelled in datasource when datasource runs it independently of connection
+	Stop()
+	IsStopped() bool
 }
 
 // BasicJob implements the common methods for Job
@@ -42,6 +83,8 @@ type BasicJob struct {
 	id             string
 	ctx            context.Context
 	cancel         context.CancelFunc
+	readCtx        context.Context
+	cancelRead     context.CancelFunc
 	status         chan int32
 	err            string
 	QueryID        string
@@ -51,13 +94,75 @@ type BasicJob struct {
 	ResultID       *string
 	ProcessedBytes int64
 	ResultSize     int64
+	ExternalID     string
 	Logger         zerolog.Logger
+	schema         []Column
+	limits         Limits
+	timer          *time.Timer
+	truncation     string
+	parameters     []Parameter
+	span           trace.Span
+	stopped        bool
 }
 
 func (j *BasicJob) Init() {
 	j.id = uuid.GetUUID()
-	j.ctx, j.cancel = context.WithTimeout(context.Background(), 10*time.Minute)
+	j.ctx, j.cancel = context.WithCancel(context.Background())
+	j.readCtx, j.cancelRead = context.WithCancel(j.ctx)
 	j.status = make(chan int32)
+	j.limits = Limits{Timeout: DefaultTimeout}
+	j.timer = time.AfterFunc(DefaultTimeout, j.timeout)
+	go func() {
+		<-j.ctx.Done()
+		j.timer.Stop()
+	}()
+}
+
+// timeout cancels job running longer than its timeout
+func (j *BasicJob) timeout() {
+	if j.ctx.Err() != nil {
+		return
+	}
+	j.Lock()
+	j.err = fmt.Sprintf("query was canceled after timeout of %s", j.limits.Timeout)
+	j.Unlock()
+	select {
+	case j.status <- int32(proto.Query_JOB_STATUS_UNSPECIFIED):
+	case <-j.ctx.Done():
+	}
+	j.cancel()
+}
+
+// SetLimits of job, zero timeout means job is not canceled by timer
+func (j *BasicJob) SetLimits(limits Limits) {
+	j.Lock()
+	defer j.Unlock()
+	j.limits = limits
+	if limits.Timeout > 0 {
+		j.timer.Reset(limits.Timeout)
+	} else {
+		j.timer.Stop()
+	}
+}
+
+func (j *BasicJob) GetLimits() Limits {
+	j.Lock()
+	defer j.Unlock()
+	return j.limits
+}
+
+func (j *BasicJob) GetTruncation() string {
+	j.Lock()
+	defer j.Unlock()
+	return j.truncation
+}
+
+// truncate result to rows written before limit was reached
+func (j *BasicJob) truncate(rows int64, reason string) {
+	j.Lock()
+	defer j.Unlock()
+	j.TotalRows = rows
+	j.truncation = reason
 }
 
 func (j *BasicJob) GetProcessedBytes() int64 {
@@ -72,6 +177,25 @@ func (j *BasicJob) GetResultSize() int64 {
 	return j.ResultSize
 }
 
+func (j *BasicJob) GetExternalID() string {
+	j.Lock()
+	defer j.Unlock()
+	return j.ExternalID
+}
+
+// SetSchema of result columns
+func (j *BasicJob) SetSchema(schema []Column) {
+	j.Lock()
+	defer j.Unlock()
+	j.schema = schema
+}
+
+func (j *BasicJob) GetSchema() []Column {
+	j.Lock()
+	defer j.Unlock()
+	return j.schema
+}
+
 func (j *BasicJob) GetResultID() *string {
 	j.Lock()
 	defer j.Unlock()
@@ -82,6 +206,19 @@ func (j *BasicJob) Cancel() {
 	j.cancel()
 }
 
+func (j *BasicJob) Stop() {
+	j.Lock()
+	j.stopped = true
+	j.Unlock()
+	j.cancel()
+}
+
+func (j *BasicJob) IsStopped() bool {
+	j.Lock()
+	defer j.Unlock()
+	return j.stopped
+}
+
 func (j *BasicJob) Status() chan int32 {
 	return j.status
 }
@@ -108,8 +245,24 @@ func (j *BasicJob) GetTotalRows() int64 {
 	return j.TotalRows
 }
 
+// GetCtx of job, carries span of job when job is traced
 func (j *BasicJob) GetCtx() context.Context {
-	return j.ctx
+	j.Lock()
+	defer j.Unlock()
+	if j.span == nil {
+		return j.ctx
+	}
+	return trace.ContextWithSpan(j.ctx, j.span)
+}
+
+// GetReadCtx is done when job is done or its result is truncated; readers of result rows stop when it is done
+func (j *BasicJob) GetReadCtx() context.Context {
+	j.Lock()
+	defer j.Unlock()
+	if j.span == nil {
+		return j.readCtx
+	}
+	return trace.ContextWithSpan(j.readCtx, j.span)
 }
 
 func (j *BasicJob) Err() string {
@@ -160,6 +313,13 @@ func (s *BasicStore) RemoveJobWhenDone(job Job) {
 	s.Unlock()
 }
 
+// RunningJobs in store
+func (s *BasicStore) RunningJobs() int {
+	s.Lock()
+	defer s.Unlock()
+	return len(s.Jobs)
+}
+
 func (s *BasicStore) Cancel(queryID string) bool {
 	s.Lock()
 	log.Debug().Str("queryID", queryID).Int("jobs", len(s.Jobs)).Msg("Canceling query in store")
@@ -186,3 +346,12 @@ func (s *BasicStore) CancelAll(ctx context.Context) {
 	}
 	s.Unlock()
 }
+
+// StopAll jobs without sending status
+func (s *BasicStore) StopAll() {
+	s.Lock()
+	for _, job := range s.Jobs {
+		job.Stop()
+	}
+	s.Unlock()
+}
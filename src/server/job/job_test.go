@@ -0,0 +1,41 @@
+package job
+
+import (
+	"testing"
+
+	"dekart/src/server/storage"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// idleJob does nothing until it is done
+type idleJob struct {
+	BasicJob
+}
+
+func (j *idleJob) Run(storageObject storage.StorageObject) error {
+	return nil
+}
+
+func TestStopAll(t *testing.T) {
+	s := &BasicStore{}
+	stopped := &idleJob{BasicJob{Logger: zerolog.Nop()}}
+	stopped.Init()
+	s.StoreJob(stopped)
+	cancelled := &idleJob{BasicJob{Logger: zerolog.Nop()}}
+	cancelled.Init()
+	cancelled.Cancel()
+
+	s.StopAll()
+	<-stopped.GetCtx().Done()
+	require.True(t, stopped.IsStopped())
+	require.False(t, cancelled.IsStopped())
+
+	// no status is sent, so job is not marked as cancelled
+	select {
+	case status := <-stopped.Status():
+		t.Fatalf("unexpected status %d", status)
+	default:
+	}
+}
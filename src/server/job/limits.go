@@ -0,0 +1,85 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// DefaultTimeout of job when DEKART_JOB_TIMEOUT is not set
+const DefaultTimeout = 10 * time.Minute
+
+// Limits of query job; zero limit means no limit
+type Limits struct {
+	Timeout        time.Duration
+	MaxRows        int64 // rows of result, header is not counted
+	MaxResultBytes int64 // size of result object, checked after each row so result may exceed it by size of buffered rows
+}
+
+// ReadLimits from DEKART_JOB_TIMEOUT, DEKART_MAX_RESULT_ROWS and DEKART_MAX_RESULT_BYTES of datasource
+func ReadLimits(config Config) (Limits, error) {
+	limits := Limits{Timeout: DefaultTimeout}
+	if value := config.Getenv("DEKART_JOB_TIMEOUT"); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout < 0 {
+			return limits, fmt.Errorf("invalid DEKART_JOB_TIMEOUT %s", value)
+		}
+		limits.Timeout = timeout
+	}
+	var err error
+	limits.MaxRows, err = readLimit(config, "DEKART_MAX_RESULT_ROWS")
+	if err != nil {
+		return limits, err
+	}
+	limits.MaxResultBytes, err = readLimit(config, "DEKART_MAX_RESULT_BYTES")
+	return limits, err
+}
+
+func readLimit(config Config, name string) (int64, error) {
+	value := config.Getenv(name)
+	if value == "" {
+		return 0, nil
+	}
+	limit, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || limit < 0 {
+		return 0, fmt.Errorf("invalid %s %s", name, value)
+	}
+	return limit, nil
+}
+
+// LimitsResult is true when rows or size of result are limited
+func (l Limits) LimitsResult() bool {
+	return l.MaxRows > 0 || l.MaxResultBytes > 0
+}
+
+// Restrict limits with query limits; query can only make limits stricter
+func (l Limits) Restrict(query Limits) Limits {
+	return Limits{
+		Timeout:        time.Duration(minLimit(int64(l.Timeout), int64(query.Timeout))),
+		MaxRows:        minLimit(l.MaxRows, query.MaxRows),
+		MaxResultBytes: minLimit(l.MaxResultBytes, query.MaxResultBytes),
+	}
+}
+
+func minLimit(a, b int64) int64 {
+	if a == 0 || (b > 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// ErrResultTruncated is returned by ResultWriter when result reached limit of the job; rows written before
+// are the result, job should close result writer as usual and stop writing rows
+var ErrResultTruncated = errors.New("result truncated")
+
+// ResultTruncated returns true when err of ResultWriter means result reached limit of the job;
+// read context is canceled so readers stop fetching remaining rows
+func (j *BasicJob) ResultTruncated(err error) bool {
+	if err != ErrResultTruncated {
+		return false
+	}
+	j.Logger.Info().Str("truncation", j.GetTruncation()).Msg("Result truncated")
+	j.cancelRead()
+	return true
+}
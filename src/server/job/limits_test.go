@@ -0,0 +1,99 @@
+package job
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestrict(t *testing.T) {
+	datasource := Limits{Timeout: 10 * time.Minute, MaxRows: 1000}
+
+	// zero query limit keeps datasource limit
+	require.Equal(t, datasource, datasource.Restrict(Limits{}))
+
+	// query limits are stricter
+	require.Equal(t,
+		Limits{Timeout: time.Minute, MaxRows: 10, MaxResultBytes: 100},
+		datasource.Restrict(Limits{Timeout: time.Minute, MaxRows: 10, MaxResultBytes: 100}),
+	)
+
+	// query cannot loosen datasource limit
+	require.Equal(t,
+		Limits{Timeout: 10 * time.Minute, MaxRows: 1000},
+		datasource.Restrict(Limits{Timeout: time.Hour, MaxRows: 5000}),
+	)
+
+	require.False(t, Limits{Timeout: time.Minute}.LimitsResult())
+	require.True(t, Limits{MaxResultBytes: 1}.LimitsResult())
+}
+
+func newLimitedJob(limits Limits) *BasicJob {
+	j := &BasicJob{Logger: zerolog.Nop()}
+	j.Init()
+	j.SetLimits(limits)
+	return j
+}
+
+func TestLimitedResultWriterRows(t *testing.T) {
+	j := newLimitedJob(Limits{MaxRows: 2})
+	defer j.Cancel()
+	var buf bytes.Buffer
+	w := j.NewResultWriter(&buf)
+
+	// header is not counted
+	require.NoError(t, w.Write([]string{"id"}))
+	require.NoError(t, w.Write([]string{"1"}))
+	require.NoError(t, w.Write([]string{"2"}))
+	err := w.Write([]string{"3"})
+	require.Equal(t, ErrResultTruncated, err)
+	require.NoError(t, w.Flush())
+	require.Equal(t, "id\n1\n2\n", buf.String())
+	require.Equal(t, int64(2), j.GetTotalRows())
+	require.Contains(t, j.GetTruncation(), "2 rows")
+
+	// readers are stopped, job itself continues to store result
+	require.True(t, j.ResultTruncated(err))
+	require.Error(t, j.GetReadCtx().Err())
+	require.NoError(t, j.GetCtx().Err())
+}
+
+func TestLimitedResultWriterBytes(t *testing.T) {
+	j := newLimitedJob(Limits{MaxResultBytes: 10})
+	defer j.Cancel()
+	var buf bytes.Buffer
+	w := j.NewResultWriter(&buf)
+
+	require.NoError(t, w.Write([]string{"name"}))
+	var err error
+	rows := 0
+	for err == nil {
+		err = w.Write([]string{strings.Repeat("a", 4)})
+		if err == nil {
+			rows++
+		}
+		// size is counted when buffered rows are flushed
+		require.NoError(t, w.Flush())
+	}
+	// header and first row are 10 bytes
+	require.Equal(t, ErrResultTruncated, err)
+	require.Equal(t, 1, rows)
+	require.Equal(t, int64(1), j.GetTotalRows())
+	require.Equal(t, "name\naaaa\n", buf.String())
+	require.Contains(t, j.GetTruncation(), "10 bytes")
+}
+
+func TestResultTruncated(t *testing.T) {
+	j := newLimitedJob(Limits{})
+	defer j.Cancel()
+	require.False(t, j.ResultTruncated(nil))
+	require.NoError(t, j.GetReadCtx().Err())
+
+	// read context is done with job
+	j.Cancel()
+	require.Error(t, j.GetReadCtx().Err())
+}
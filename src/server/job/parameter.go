@@ -0,0 +1,203 @@
+package job
+
+import (
+	"dekart/src/proto"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UserEmailParameter is built-in parameter with email of the user running query or author of schedule;
+// result of query referencing it is shown only to that user
+const UserEmailParameter = "user_email"
+
+// ParameterNameRe is valid parameter name
+var ParameterNameRe = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+var parameterRefRe = regexp.MustCompile(`^\{\{\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*\}\}`)
+
+// parameterRef is position of {{name}} in query text
+type parameterRef struct {
+	start, end int
+	name       string
+}
+
+// parameterRefs in query text; references inside string literals, quoted identifiers and comments are ignored.
+// Quote is escaped by doubling it or with backslash
+func parameterRefs(queryText string) []parameterRef {
+	refs := make([]parameterRef, 0)
+	for i := 0; i < len(queryText); {
+		rest := queryText[i:]
+		switch {
+		case rest[0] == '\'' || rest[0] == '"' || rest[0] == '`':
+			i = skipQuoted(queryText, i)
+		case strings.HasPrefix(rest, "--"):
+			i = skipUntil(queryText, i+2, "\n")
+		case strings.HasPrefix(rest, "/*"):
+			i = skipUntil(queryText, i+2, "*/")
+		case strings.HasPrefix(rest, "{{"):
+			match := parameterRefRe.FindStringSubmatch(rest)
+			if match == nil {
+				i += 2
+				continue
+			}
+			refs = append(refs, parameterRef{start: i, end: i + len(match[0]), name: match[1]})
+			i += len(match[0])
+		default:
+			i++
+		}
+	}
+	return refs
+}
+
+// skipQuoted returns position after literal or identifier which starts with quote at i
+func skipQuoted(s string, i int) int {
+	quote := s[i]
+	for i++; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case quote:
+			if i+1 < len(s) && s[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// skipUntil returns position after end found from i or end of s
+func skipUntil(s string, i int, end string) int {
+	if j := strings.Index(s[i:], end); j >= 0 {
+		return i + j + len(end)
+	}
+	return len(s)
+}
+
+// Parameter referenced in query text as {{name}}
+type Parameter struct {
+	Name  string
+	Type  proto.ReportParameter_Type
+	Value string
+}
+
+// ParameterNames referenced in query text in order of first reference
+func ParameterNames(queryText string) []string {
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, ref := range parameterRefs(queryText) {
+		if !seen[ref.name] {
+			seen[ref.name] = true
+			names = append(names, ref.name)
+		}
+	}
+	return names
+}
+
+// Validate value of parameter
+func (p Parameter) Validate() error {
+	var err error
+	switch p.Type {
+	case proto.ReportParameter_TYPE_STRING:
+	case proto.ReportParameter_TYPE_NUMBER:
+		var f float64
+		f, err = strconv.ParseFloat(p.Value, 64)
+		if err == nil && (math.IsNaN(f) || math.IsInf(f, 0)) {
+			err = fmt.Errorf("not finite number")
+		}
+	case proto.ReportParameter_TYPE_DATE:
+		_, err = time.Parse("2006-01-02", p.Value)
+	case proto.ReportParameter_TYPE_BOOLEAN:
+		_, err = strconv.ParseBool(p.Value)
+	default:
+		return fmt.Errorf("parameter %s has unknown type", p.Name)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid value %q of parameter %s", p.Value, p.Name)
+	}
+	return nil
+}
+
+// Native value of validated parameter for database drivers; dates are strings
+func (p Parameter) Native() interface{} {
+	switch p.Type {
+	case proto.ReportParameter_TYPE_NUMBER:
+		if i, err := strconv.ParseInt(p.Value, 10, 64); err == nil {
+			return i
+		}
+		f, _ := strconv.ParseFloat(p.Value, 64)
+		return f
+	case proto.ReportParameter_TYPE_BOOLEAN:
+		b, _ := strconv.ParseBool(p.Value)
+		return b
+	}
+	return p.Value
+}
+
+// Literal of validated parameter in SQL, for datasources which cannot bind parameters
+func (p Parameter) Literal() string {
+	switch x := p.Native().(type) {
+	case int64:
+		return strconv.FormatInt(x, 10)
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(x)
+	}
+	quoted := "'" + strings.ReplaceAll(p.Value, "'", "''") + "'"
+	if p.Type == proto.ReportParameter_TYPE_DATE {
+		return "DATE " + quoted
+	}
+	return quoted
+}
+
+// NativeValues of parameters
+func NativeValues(params []Parameter) []interface{} {
+	values := make([]interface{}, len(params))
+	for i, p := range params {
+		values[i] = p.Native()
+	}
+	return values
+}
+
+// BindParameters replaces parameter references with placeholders of datasource, n is 1-based number of reference;
+// returned parameters are in order of references, parameter referenced twice is returned twice
+func BindParameters(queryText string, params []Parameter, placeholder func(n int, p Parameter) string) (string, []Parameter, error) {
+	byName := make(map[string]Parameter, len(params))
+	for _, p := range params {
+		byName[p.Name] = p
+	}
+	bound := make([]Parameter, 0)
+	var b strings.Builder
+	last := 0
+	for _, ref := range parameterRefs(queryText) {
+		p, ok := byName[ref.name]
+		if !ok {
+			return "", nil, fmt.Errorf("parameter %s is not defined", ref.name)
+		}
+		bound = append(bound, p)
+		b.WriteString(queryText[last:ref.start])
+		b.WriteString(placeholder(len(bound), p))
+		last = ref.end
+	}
+	b.WriteString(queryText[last:])
+	return b.String(), bound, nil
+}
+
+// SetParameters referenced in query text, they are bound when job is started
+func (j *BasicJob) SetParameters(params []Parameter) {
+	j.Lock()
+	defer j.Unlock()
+	j.parameters = params
+}
+
+func (j *BasicJob) GetParameters() []Parameter {
+	j.Lock()
+	defer j.Unlock()
+	return j.parameters
+}
@@ -0,0 +1,67 @@
+package job
+
+import (
+	"fmt"
+	"testing"
+
+	"dekart/src/proto"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindParameters(t *testing.T) {
+	params := []Parameter{
+		{Name: "region", Type: proto.ReportParameter_TYPE_STRING, Value: "O'Hare"},
+		{Name: "start_date", Type: proto.ReportParameter_TYPE_DATE, Value: "2021-03-04"},
+		{Name: "min", Type: proto.ReportParameter_TYPE_NUMBER, Value: "10"},
+	}
+	queryText := "select * from t where region = {{ region }} and day >= {{start_date}} and n > {{min}} or region = {{region}}"
+	require.Equal(t, []string{"region", "start_date", "min"}, ParameterNames(queryText))
+
+	bound, args, err := BindParameters(queryText, params, func(n int, p Parameter) string {
+		return fmt.Sprintf("$%d", n)
+	})
+	require.NoError(t, err)
+	require.Equal(t, "select * from t where region = $1 and day >= $2 and n > $3 or region = $4", bound)
+	require.Equal(t, []interface{}{"O'Hare", "2021-03-04", int64(10), "O'Hare"}, NativeValues(args))
+
+	literal, _, err := BindParameters(queryText, params, func(n int, p Parameter) string {
+		return p.Literal()
+	})
+	require.NoError(t, err)
+	require.Equal(t, "select * from t where region = 'O''Hare' and day >= DATE '2021-03-04' and n > 10 or region = 'O''Hare'", literal)
+
+	_, _, err = BindParameters("select {{unknown}}", params, func(n int, p Parameter) string { return "?" })
+	require.EqualError(t, err, "parameter unknown is not defined")
+}
+
+func TestParameterValidate(t *testing.T) {
+	require.NoError(t, Parameter{Name: "n", Type: proto.ReportParameter_TYPE_NUMBER, Value: "1.5"}.Validate())
+	require.Error(t, Parameter{Name: "n", Type: proto.ReportParameter_TYPE_NUMBER, Value: "1; drop table t"}.Validate())
+	require.Error(t, Parameter{Name: "n", Type: proto.ReportParameter_TYPE_NUMBER, Value: "NaN"}.Validate())
+	require.Error(t, Parameter{Name: "d", Type: proto.ReportParameter_TYPE_DATE, Value: "2021-13-01"}.Validate())
+	require.Error(t, Parameter{Name: "b", Type: proto.ReportParameter_TYPE_BOOLEAN, Value: "yes"}.Validate())
+	require.Error(t, Parameter{Name: "x", Value: "1"}.Validate())
+}
+
+func TestParameterRefsOutsideLiterals(t *testing.T) {
+	queryText := `select '{{a}}', "{{b}}", ` + "`{{c}}`" + `, 'it''s {{d}}', 'x\'{{e}}' -- {{f}}
+	/* {{g}}
+	*/ from t where x = {{h}} and y = '}}' and z = {{ i }} and w = {{1}}`
+	require.Equal(t, []string{"h", "i"}, ParameterNames(queryText))
+
+	params := []Parameter{
+		{Name: "h", Type: proto.ReportParameter_TYPE_NUMBER, Value: "1"},
+		{Name: "i", Type: proto.ReportParameter_TYPE_STRING, Value: "v"},
+	}
+	bound, args, err := BindParameters("select '{{h}}' -- {{i}}\n, {{h}}, {{i}}", params, func(n int, p Parameter) string {
+		return fmt.Sprintf("$%d", n)
+	})
+	require.NoError(t, err)
+	require.Equal(t, "select '{{h}}' -- {{i}}\n, $1, $2", bound)
+	require.Equal(t, []interface{}{int64(1), "v"}, NativeValues(args))
+
+	// unterminated literal and comment
+	require.Empty(t, ParameterNames("select '{{a}}"))
+	require.Empty(t, ParameterNames("select 1 /* {{a}}"))
+}
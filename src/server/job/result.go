@@ -0,0 +1,122 @@
+package job
+
+import (
+	"dekart/src/server/convert"
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// Column of query result schema
+type Column struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"` // type in datasource, e.g. INTEGER or GEOGRAPHY
+	Nullable bool   `json:"nullable"`
+	Geometry bool   `json:"geometry"`
+	// ResultType of column in Parquet download: int64, float64 or bool; other columns are written as strings
+	ResultType string `json:"resultType,omitempty"`
+}
+
+// ResultWriter encodes query result rows; the first row is header with column names
+type ResultWriter interface {
+	Write(row []string) error
+	// Flush buffered rows, underlying writer is not closed
+	Flush() error
+}
+
+// NewResultWriter of CSV result; Write returns ErrResultTruncated when result reached row or size limit of the job
+func (j *BasicJob) NewResultWriter(w io.Writer) ResultWriter {
+	counter := &countingWriter{w: w}
+	return &limitedResultWriter{
+		ResultWriter: &csvResultWriter{csv.NewWriter(counter)},
+		job:          j,
+		limits:       j.GetLimits(),
+		counter:      counter,
+	}
+}
+
+// countingWriter counts bytes written to result object
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+type limitedResultWriter struct {
+	ResultWriter
+	job     *BasicJob
+	limits  Limits
+	counter *countingWriter
+	header  bool // header row was written
+	rows    int64
+}
+
+func (l *limitedResultWriter) Write(row []string) error {
+	if !l.header {
+		l.header = true
+		return l.ResultWriter.Write(row)
+	}
+	if l.limits.MaxRows > 0 && l.rows >= l.limits.MaxRows {
+		l.job.truncate(l.rows, fmt.Sprintf("Result is truncated to %d rows, the limit of datasource or query", l.rows))
+		return ErrResultTruncated
+	}
+	if l.limits.MaxResultBytes > 0 && l.counter.n >= l.limits.MaxResultBytes {
+		l.job.truncate(l.rows, fmt.Sprintf("Result is truncated to %d rows, result size limit is %d bytes", l.rows, l.limits.MaxResultBytes))
+		return ErrResultTruncated
+	}
+	l.rows++
+	return l.ResultWriter.Write(row)
+}
+
+type csvResultWriter struct {
+	*csv.Writer
+}
+
+func (c *csvResultWriter) Flush() error {
+	c.Writer.Flush()
+	return c.Writer.Error()
+}
+
+// ConvertResultToParquet reads CSV result and writes it as Parquet; column types are taken from result schema
+// when it matches header, w is not closed
+func ConvertResultToParquet(w io.Writer, r io.Reader, schema []Column) error {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err == io.EOF {
+		// empty result
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	columns := make([]convert.Column, len(header))
+	for i, name := range header {
+		columns[i] = convert.Column{Name: name, Type: "utf8", Nullable: true}
+		if len(schema) == len(header) && schema[i].ResultType != "" {
+			columns[i].Type = schema[i].ResultType
+		}
+	}
+	writer, err := convert.NewParquetWriter(w, columns)
+	if err != nil {
+		return err
+	}
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err == nil {
+			err = writer.Write(row)
+		}
+		if err != nil {
+			writer.Close()
+			return err
+		}
+	}
+	return writer.Close()
+}
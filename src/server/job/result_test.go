@@ -0,0 +1,40 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"dekart/src/server/convert"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertResultToParquet(t *testing.T) {
+	var buf bytes.Buffer
+	err := ConvertResultToParquet(&buf, strings.NewReader("id,name,score\n1,a,0.5\n2,,\n"), []Column{
+		{Name: "id", Type: "INTEGER", ResultType: "int64"},
+		{Name: "name", Type: "STRING", Nullable: true},
+		{Name: "score", Type: "FLOAT", Nullable: true, ResultType: "float64"},
+	})
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	result, err := convert.ParquetToCSV(context.Background(), bytes.NewReader(buf.Bytes()), &out)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), result.RowCount)
+	require.Equal(t, "int64", result.Schema[0].Type)
+	require.Equal(t, "utf8", result.Schema[1].Type)
+	require.Equal(t, "float64", result.Schema[2].Type)
+	require.Equal(t, "id,name,score\n1,a,0.5\n2,,\n", out.String())
+
+	// schema not matching header, all columns are strings
+	buf.Reset()
+	err = ConvertResultToParquet(&buf, strings.NewReader("id\n1\n"), nil)
+	require.NoError(t, err)
+	out.Reset()
+	result, err = convert.ParquetToCSV(context.Background(), bytes.NewReader(buf.Bytes()), &out)
+	require.NoError(t, err)
+	require.Equal(t, "utf8", result.Schema[0].Type)
+	require.Equal(t, "id\n1\n", out.String())
+}
@@ -0,0 +1,34 @@
+package job
+
+import (
+	"context"
+	"dekart/src/server/tracing"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// StartSpan of job; datasource and storage calls made with job context are traced as its children
+func (j *BasicJob) StartSpan(ctx context.Context) {
+	_, span := tracing.Start(ctx, "job.Run",
+		attribute.String("job.id", j.GetID()),
+		attribute.String("query.id", j.QueryID),
+		attribute.String("report.id", j.ReportID),
+	)
+	j.Lock()
+	j.span = span
+	j.Unlock()
+	go func() {
+		<-j.ctx.Done()
+		span.SetAttributes(
+			attribute.Int64("job.total_rows", j.GetTotalRows()),
+			attribute.Int64("job.processed_bytes", j.GetProcessedBytes()),
+			attribute.Int64("job.result_size", j.GetResultSize()),
+		)
+		var err error
+		if msg := j.Err(); msg != "" {
+			err = errors.New(msg)
+		}
+		tracing.End(span, err)
+	}()
+}
@@ -0,0 +1,130 @@
+package jsondiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Kind of change
+type Kind int
+
+// Change kinds
+const (
+	Added Kind = iota + 1
+	Removed
+	Changed
+)
+
+// Change of a value at Path; From and To are JSON encoded values, empty when value is missing
+type Change struct {
+	Path string
+	Kind Kind
+	From string
+	To   string
+}
+
+// Parse JSON document; invalid JSON is returned as string so it is compared as a whole
+func Parse(s string) interface{} {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return s
+	}
+	return v
+}
+
+// Diff returns changes between values decoded from JSON, objects and arrays are compared recursively;
+// changes are sorted by path
+func Diff(path string, from, to interface{}) []Change {
+	changes := make([]Change, 0)
+	diff(path, from, to, &changes)
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+func encode(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+func join(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func diff(path string, from, to interface{}, changes *[]Change) {
+	switch fromValue := from.(type) {
+	case map[string]interface{}:
+		if toValue, ok := to.(map[string]interface{}); ok {
+			diffObjects(path, fromValue, toValue, changes)
+			return
+		}
+	case []interface{}:
+		if toValue, ok := to.([]interface{}); ok {
+			diffArrays(path, fromValue, toValue, changes)
+			return
+		}
+	}
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, Change{
+			Path: path,
+			Kind: Changed,
+			From: encode(from),
+			To:   encode(to),
+		})
+	}
+}
+
+func diffObjects(path string, from, to map[string]interface{}, changes *[]Change) {
+	for key, fromValue := range from {
+		toValue, ok := to[key]
+		if !ok {
+			*changes = append(*changes, Change{
+				Path: join(path, key),
+				Kind: Removed,
+				From: encode(fromValue),
+			})
+			continue
+		}
+		diff(join(path, key), fromValue, toValue, changes)
+	}
+	for key, toValue := range to {
+		if _, ok := from[key]; !ok {
+			*changes = append(*changes, Change{
+				Path: join(path, key),
+				Kind: Added,
+				To:   encode(toValue),
+			})
+		}
+	}
+}
+
+func diffArrays(path string, from, to []interface{}, changes *[]Change) {
+	for i := 0; i < len(from) || i < len(to); i++ {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= len(to):
+			*changes = append(*changes, Change{
+				Path: itemPath,
+				Kind: Removed,
+				From: encode(from[i]),
+			})
+		case i >= len(from):
+			*changes = append(*changes, Change{
+				Path: itemPath,
+				Kind: Added,
+				To:   encode(to[i]),
+			})
+		default:
+			diff(itemPath, from[i], to[i], changes)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package jsondiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		changes []Change
+	}{
+		{
+			name:    "equal",
+			from:    `{"a":1,"b":[1,2]}`,
+			to:      `{"b":[1,2],"a":1}`,
+			changes: []Change{},
+		},
+		{
+			name: "nested value changed",
+			from: `{"mapState":{"zoom":3,"latitude":1}}`,
+			to:   `{"mapState":{"zoom":5,"latitude":1}}`,
+			changes: []Change{
+				{Path: "map_config.mapState.zoom", Kind: Changed, From: "3", To: "5"},
+			},
+		},
+		{
+			name: "keys added and removed",
+			from: `{"a":"x","b":true}`,
+			to:   `{"b":true,"c":{"d":null}}`,
+			changes: []Change{
+				{Path: "map_config.a", Kind: Removed, From: `"x"`},
+				{Path: "map_config.c", Kind: Added, To: `{"d":null}`},
+			},
+		},
+		{
+			name: "array items",
+			from: `{"layers":[{"id":"1"},{"id":"2"}]}`,
+			to:   `{"layers":[{"id":"3"}]}`,
+			changes: []Change{
+				{Path: "map_config.layers[0].id", Kind: Changed, From: `"1"`, To: `"3"`},
+				{Path: "map_config.layers[1]", Kind: Removed, From: `{"id":"2"}`},
+			},
+		},
+		{
+			name: "type changed",
+			from: `{"a":[1]}`,
+			to:   `{"a":{"0":1}}`,
+			changes: []Change{
+				{Path: "map_config.a", Kind: Changed, From: "[1]", To: `{"0":1}`},
+			},
+		},
+		{
+			name: "invalid json compared as string",
+			from: `not json`,
+			to:   `{}`,
+			changes: []Change{
+				{Path: "map_config", Kind: Changed, From: `"not json"`, To: "{}"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := Diff("map_config", Parse(tt.from), Parse(tt.to))
+			require.Equal(t, tt.changes, changes)
+		})
+	}
+}
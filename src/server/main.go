@@ -8,7 +8,8 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
-	"sync"
+	"regexp"
+	"strings"
 	"syscall"
 	"time"
 
@@ -17,11 +18,15 @@ import (
 	"dekart/src/server/bqjob"
 	"dekart/src/server/dekart"
 	"dekart/src/server/job"
+	"dekart/src/server/pgjob"
+	"dekart/src/server/report"
 	"dekart/src/server/snowflakejob"
 	"dekart/src/server/storage"
+	"dekart/src/server/tracing"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	_ "github.com/lib/pq"
 	"github.com/rs/zerolog"
@@ -52,7 +57,16 @@ func configureLogger() {
 
 }
 
-func configureDb() *sql.DB {
+// configureTracing from DEKART_TRACING_EXPORTER, returns function flushing spans on shutdown
+func configureTracing() func(context.Context) error {
+	shutdown, err := tracing.Configure(context.Background())
+	if err != nil {
+		log.Fatal().Err(err).Msg("Cannot configure tracing")
+	}
+	return shutdown
+}
+
+func postgresURL() string {
 	url, ok := os.LookupEnv("DEKART_POSTGRES_URL")
 	if !ok {
 		url = fmt.Sprintf(
@@ -64,7 +78,11 @@ func configureDb() *sql.DB {
 			os.Getenv("DEKART_POSTGRES_DB"),
 		)
 	}
-	db, err := sql.Open("postgres", url)
+	return url
+}
+
+func configureDb() *sql.DB {
+	db, err := sql.Open("postgres", postgresURL())
 	if err != nil {
 		log.Fatal().Err(err).Send()
 	}
@@ -94,6 +112,26 @@ func applyMigrations(db *sql.DB) {
 	}
 }
 
+// latestMigration version in migrations directory, health check expects database schema of this version
+func latestMigration() uint {
+	src, err := source.Open("file://migrations")
+	if err != nil {
+		log.Fatal().Err(err).Msg("Cannot open migrations")
+	}
+	defer src.Close()
+	version, err := src.First()
+	if err != nil {
+		log.Fatal().Err(err).Msg("No migrations found")
+	}
+	for {
+		next, err := src.Next(version)
+		if err != nil {
+			return version
+		}
+		version = next
+	}
+}
+
 func configureBucket() storage.Storage {
 	var bucket storage.Storage
 	switch os.Getenv("DEKART_STORAGE") {
@@ -103,31 +141,82 @@ func configureBucket() storage.Storage {
 	case "GCS", "":
 		log.Info().Msg("Using GCS storage backend")
 		bucket = storage.NewGoogleCloudStorage()
+	case "LOCAL":
+		log.Info().Msg("Using local filesystem storage backend")
+		bucket = storage.NewLocalStorage()
 	default:
 		log.Fatal().Str("DEKART_STORAGE", os.Getenv("DEKART_STORAGE")).Msg("Unknown storage backend")
 	}
 	return bucket
 }
 
-func configureJobStore(bucket storage.Storage) job.Store {
+func configureJobStore(bucket storage.Storage, datasourceType string, config job.Config) job.Store {
 	var jobStore job.Store
-	switch os.Getenv("DEKART_DATASOURCE") {
+	switch datasourceType {
 	case "SNOWFLAKE":
-		log.Info().Msg("Using Snowflake Datasource backend")
-		jobStore = snowflakejob.NewStore()
+		log.Info().Str("datasource", config.ID).Msg("Using Snowflake Datasource backend")
+		jobStore = snowflakejob.NewStore(config)
 	case "ATHENA":
-		log.Info().Msg("Using Athena Datasource backend")
-		jobStore = athenajob.NewStore(bucket)
-	case "BQ", "":
-		log.Info().Msg("Using BigQuery Datasource backend")
-		jobStore = bqjob.NewStore()
+		log.Info().Str("datasource", config.ID).Msg("Using Athena Datasource backend")
+		jobStore = athenajob.NewStore(bucket, config)
+	case "PG":
+		log.Info().Str("datasource", config.ID).Msg("Using PostgreSQL Datasource backend")
+		jobStore = pgjob.NewStore(config)
+	case "BQ":
+		log.Info().Str("datasource", config.ID).Msg("Using BigQuery Datasource backend")
+		jobStore = bqjob.NewStore(config)
 	default:
-		log.Fatal().Str("DEKART_STORAGE", os.Getenv("DEKART_STORAGE")).Msg("Unknown storage backend")
+		log.Fatal().Str("datasource", config.ID).Str("type", datasourceType).Msg("Unknown datasource backend")
 	}
 
 	return jobStore
 }
 
+// configureLimits of datasource jobs; row and size limits cannot be set for Athena which copies results
+func configureLimits(config job.Config, datasourceType string) job.Limits {
+	limits, err := job.ReadLimits(config)
+	if err != nil {
+		log.Fatal().Err(err).Str("datasource", config.ID).Msg("Invalid job limits")
+	}
+	if limits.LimitsResult() && !(job.Datasource{Type: datasourceType}).LimitsResult() {
+		log.Fatal().Str("datasource", config.ID).Msg("DEKART_MAX_RESULT_ROWS and DEKART_MAX_RESULT_BYTES are not supported by Athena")
+	}
+	return limits
+}
+
+var datasourceIDRe = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+// configureDatasources registers job stores listed in DEKART_DATASOURCES as id:TYPE pairs,
+// e.g. DEKART_DATASOURCES=warehouse:BQ,gis:PG; without it single DEKART_DATASOURCE is used
+func configureDatasources(bucket storage.Storage) *job.Datasources {
+	datasources := job.NewDatasources()
+	list := os.Getenv("DEKART_DATASOURCES")
+	if list == "" {
+		datasourceType := os.Getenv("DEKART_DATASOURCE")
+		if datasourceType == "" {
+			datasourceType = "BQ"
+		}
+		id := strings.ToLower(datasourceType)
+		config := job.Config{ID: id}
+		datasources.Register(id, datasourceType, configureJobStore(bucket, datasourceType, config), configureLimits(config, datasourceType))
+		return datasources
+	}
+	for _, item := range strings.Split(list, ",") {
+		parts := strings.Split(strings.TrimSpace(item), ":")
+		if len(parts) != 2 || !datasourceIDRe.MatchString(parts[0]) {
+			log.Fatal().Str("DEKART_DATASOURCES", list).Msgf("Invalid datasource %s, expected id:TYPE", item)
+		}
+		id := parts[0]
+		if _, exists := datasources.Get(id); exists {
+			log.Fatal().Str("DEKART_DATASOURCES", list).Msgf("Duplicate datasource %s", id)
+		}
+		datasourceType := strings.ToUpper(parts[1])
+		config := job.Config{ID: id}
+		datasources.Register(id, datasourceType, configureJobStore(bucket, datasourceType, config), configureLimits(config, datasourceType))
+	}
+	return datasources
+}
+
 func startHttpServer(httpServer *http.Server) {
 	err := httpServer.ListenAndServe()
 	if err != nil {
@@ -139,6 +228,43 @@ func startHttpServer(httpServer *http.Server) {
 	}
 }
 
+func configureReportStreams(ctx context.Context, db *sql.DB, dekartServer *dekart.Server) {
+	switch os.Getenv("DEKART_REPORT_STREAMS_NOTIFIER") {
+	case "POSTGRES":
+		log.Info().Msg("Sharing report streams with Postgres LISTEN/NOTIFY")
+		notifier := report.NewPostgresNotifier(db, postgresURL())
+		go func() {
+			err := dekartServer.ListenReportStreams(ctx, notifier)
+			if err != nil {
+				log.Fatal().Err(err).Msg("Cannot listen report streams notifications")
+			}
+		}()
+	case "":
+	default:
+		log.Fatal().Str("DEKART_REPORT_STREAMS_NOTIFIER", os.Getenv("DEKART_REPORT_STREAMS_NOTIFIER")).Msg("Unknown report streams notifier")
+	}
+}
+
+// defaultShutdownGracePeriod fits default 30s termination grace period of Kubernetes pods
+const defaultShutdownGracePeriod = 25 * time.Second
+
+// shutdownStepTimeout of http server and tracing shutdown, each is given this time at the end of grace period
+const shutdownStepTimeout = 2 * time.Second
+
+// shutdownGracePeriod from DEKART_SHUTDOWN_GRACE_PERIOD, time shutdown takes at most; running jobs are given
+// the time left after releasing unfinished jobs, http server and tracing shutdown
+func shutdownGracePeriod() time.Duration {
+	value := os.Getenv("DEKART_SHUTDOWN_GRACE_PERIOD")
+	if value == "" {
+		return defaultShutdownGracePeriod
+	}
+	gracePeriod, err := time.ParseDuration(value)
+	if err != nil || gracePeriod < 0 {
+		log.Fatal().Str("DEKART_SHUTDOWN_GRACE_PERIOD", value).Msg("Invalid shutdown grace period")
+	}
+	return gracePeriod
+}
+
 func waitForInterrupt() chan os.Signal {
 	var s = make(chan os.Signal, 1)
 	signal.Notify(s, syscall.SIGTERM)
@@ -148,6 +274,8 @@ func waitForInterrupt() chan os.Signal {
 
 func main() {
 	configureLogger()
+	shutdownTracing := configureTracing()
+	gracePeriod := shutdownGracePeriod()
 
 	db := configureDb()
 	defer db.Close()
@@ -155,39 +283,47 @@ func main() {
 	applyMigrations(db)
 
 	bucket := configureBucket()
-	jobStore := configureJobStore(bucket)
+	datasources := configureDatasources(bucket)
 
-	dekartServer := dekart.NewServer(db, bucket, jobStore)
+	dekartServer := dekart.NewServer(db, bucket, datasources)
+	dekartServer.SetMigrationVersion(latestMigration())
 	httpServer := app.Configure(dekartServer)
 
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	go dekartServer.ResumeJobs(backgroundCtx)
+	go dekartServer.RunSchedules(backgroundCtx)
+	configureReportStreams(backgroundCtx, db, dekartServer)
+
 	go startHttpServer(httpServer)
 
 	sig := <-waitForInterrupt()
 
 	// shutdown gracefully
-	log.Info().Str("signal", sig.String()).Msg("shutdown signal received")
-	var wg sync.WaitGroup
-	wg.Add(2)
-
-	shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-	defer cancel()
+	log.Info().Str("signal", sig.String()).Dur("gracePeriod", gracePeriod).Msg("shutdown signal received")
+	shutdown := make(chan bool)
 
 	go func() {
-		defer wg.Done()
-		dekartServer.Shutdown(shutdownCtx)
+		// all steps fit grace period; running jobs are drained first, http server keeps serving their status
+		// and results meanwhile
+		deadline := time.Now().Add(gracePeriod)
+		drainCtx, cancelDrain := context.WithDeadline(context.Background(), deadline.Add(-2*shutdownStepTimeout))
+		defer cancelDrain()
+		dekartServer.Shutdown(drainCtx)
 		log.Debug().Msg("dekart server shutdown complete")
-	}()
 
-	go func() {
-		defer wg.Done()
+		shutdownCtx, cancel := context.WithDeadline(context.Background(), deadline.Add(-shutdownStepTimeout))
+		defer cancel()
 		httpServer.Shutdown(shutdownCtx)
 		log.Debug().Msg("http server shutdown complete")
-	}()
-
-	shutdown := make(chan bool)
 
-	go func() {
-		wg.Wait()
+		// spans of jobs stopped on shutdown are flushed too
+		tracingCtx, cancelTracing := context.WithDeadline(context.Background(), deadline)
+		defer cancelTracing()
+		err := shutdownTracing(tracingCtx)
+		if err != nil {
+			log.Warn().Err(err).Msg("Cannot flush tracing spans")
+		}
 		close(shutdown)
 	}()
 
@@ -0,0 +1,147 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// job statuses of JobsFinished
+const (
+	JobDone      = "done"
+	JobFailed    = "failed"
+	JobCancelled = "cancelled"
+)
+
+var (
+	grpcRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "dekart_grpc_request_duration_seconds",
+		Help:    "Latency of unary gRPC requests.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "code"})
+	grpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dekart_grpc_requests_total",
+		Help: "gRPC requests and streams by result code.",
+	}, []string{"method", "code"})
+
+	// JobsCreated by RunQuery and schedules
+	JobsCreated = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dekart_jobs_created_total",
+		Help: "Query jobs created.",
+	}, []string{"datasource"})
+	// JobsRunning on this instance, including resumed jobs
+	JobsRunning = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dekart_jobs_running",
+		Help: "Query jobs running on this instance.",
+	}, []string{"datasource"})
+	// JobsFinished by status: done, failed or cancelled
+	JobsFinished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dekart_jobs_finished_total",
+		Help: "Query jobs finished by status.",
+	}, []string{"datasource", "status"})
+	// JobProcessedBytes reported by datasource
+	JobProcessedBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dekart_job_processed_bytes_total",
+		Help: "Bytes processed by query jobs as reported by datasource.",
+	}, []string{"datasource"})
+	// JobResultSize of result objects
+	JobResultSize = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "dekart_job_result_size_bytes",
+		Help:    "Size of query job results.",
+		Buckets: prometheus.ExponentialBuckets(1024, 4, 12), // 1KB to 4GB
+	}, []string{"datasource"})
+
+	// ReportStreamSubscribers connected to this instance
+	ReportStreamSubscribers = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dekart_report_stream_subscribers",
+		Help: "Active report stream subscribers.",
+	})
+
+	// StorageReadBytes from storage objects
+	StorageReadBytes = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dekart_storage_read_bytes_total",
+		Help: "Bytes read from storage.",
+	})
+	// StorageWrittenBytes to storage objects
+	StorageWrittenBytes = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dekart_storage_written_bytes_total",
+		Help: "Bytes written to storage.",
+	})
+
+	// UploadDuration of storing uploaded file, including conversion, by upload kind: file or chunked
+	UploadDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "dekart_upload_duration_seconds",
+		Help:    "Duration of storing uploaded files.",
+		Buckets: prometheus.ExponentialBuckets(0.1, 2, 14), // 100ms to 27m
+	}, []string{"kind", "status"})
+)
+
+// Handler serves metrics in Prometheus format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// UnaryServerInterceptor records latency and result code of gRPC requests
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	res, err := handler(ctx, req)
+	code := status.Code(err).String()
+	grpcRequestDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(start).Seconds())
+	grpcRequests.WithLabelValues(info.FullMethod, code).Inc()
+	return res, err
+}
+
+// StreamServerInterceptor records result code of gRPC streams, streams are long lived so latency is not recorded
+func StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, ss)
+	grpcRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+	return err
+}
+
+// ObserveUpload duration since start
+func ObserveUpload(kind string, start time.Time, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	UploadDuration.WithLabelValues(kind, result).Observe(time.Since(start).Seconds())
+}
+
+type countingReader struct {
+	io.ReadCloser
+	counter prometheus.Counter
+}
+
+func (r countingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.counter.Add(float64(n))
+	return n, err
+}
+
+// CountRead adds bytes read from reader to StorageReadBytes
+func CountRead(r io.ReadCloser) io.ReadCloser {
+	return countingReader{r, StorageReadBytes}
+}
+
+type countingWriter struct {
+	io.WriteCloser
+	counter prometheus.Counter
+}
+
+func (w countingWriter) Write(p []byte) (int, error) {
+	n, err := w.WriteCloser.Write(p)
+	w.counter.Add(float64(n))
+	return n, err
+}
+
+// CountWrite adds bytes written to writer to StorageWrittenBytes
+func CountWrite(w io.WriteCloser) io.WriteCloser {
+	return countingWriter{w, StorageWrittenBytes}
+}
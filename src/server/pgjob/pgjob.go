@@ -0,0 +1,342 @@
+package pgjob
+
+import (
+	"context"
+	"database/sql"
+	"dekart/src/proto"
+	"dekart/src/server/geom"
+	"dekart/src/server/job"
+	"dekart/src/server/storage"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	_ "github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+)
+
+// Store implements job.Store interface for PostgreSQL and PostGIS
+type Store struct {
+	job.BasicStore
+	db             *sql.DB
+	geometryFormat string
+}
+
+// Ping connection to datasource
+func (s *Store) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+// NewStore connects to DEKART_POSTGRES_DATASOURCE_CONNECTION
+func NewStore(config job.Config) *Store {
+	connection := config.Getenv("DEKART_POSTGRES_DATASOURCE_CONNECTION")
+	if connection == "" {
+		log.Fatal().Msg("postgres data source require DEKART_POSTGRES_DATASOURCE_CONNECTION")
+	}
+	db, err := sql.Open("postgres", connection)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to connect to postgres data source")
+	}
+	geometryFormat := strings.ToUpper(defaultString(config.Getenv("DEKART_POSTGRES_DATASOURCE_GEOMETRY_FORMAT"), "WKT"))
+	switch geometryFormat {
+	case "WKT", "GEOJSON":
+	default:
+		log.Fatal().Str("DEKART_POSTGRES_DATASOURCE_GEOMETRY_FORMAT", geometryFormat).Msg("Unknown geometry format")
+	}
+	return &Store{
+		db:             db,
+		geometryFormat: geometryFormat,
+	}
+}
+
+func defaultString(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+// Create job on store
+func (s *Store) Create(reportID string, queryID string, queryText string) (job.Job, chan int32, error) {
+	job := &Job{
+		BasicJob: job.BasicJob{
+			ReportID:  reportID,
+			QueryID:   queryID,
+			QueryText: queryText,
+			Logger:    log.With().Str("reportID", reportID).Str("queryID", queryID).Logger(),
+		},
+		db:             s.db,
+		geometryFormat: s.geometryFormat,
+	}
+	job.Init()
+	s.StoreJob(job)
+	go s.RemoveJobWhenDone(job)
+	return job, job.Status(), nil
+}
+
+// pgPlanNode of EXPLAIN (FORMAT JSON) output
+type pgPlanNode struct {
+	RelationName string       `json:"Relation Name"`
+	PlanRows     float64      `json:"Plan Rows"`
+	PlanWidth    int64        `json:"Plan Width"`
+	Plans        []pgPlanNode `json:"Plans"`
+}
+
+// scanBytes sums estimated rows times row width of relation scans
+func (n pgPlanNode) scanBytes() int64 {
+	var bytes int64
+	if n.RelationName != "" {
+		bytes += int64(n.PlanRows) * n.PlanWidth
+	}
+	for _, child := range n.Plans {
+		bytes += child.scanBytes()
+	}
+	return bytes
+}
+
+// Estimate bytes read by query from planner estimates, PostgreSQL has no exact scanned bytes estimate
+func (s *Store) Estimate(ctx context.Context, queryText string, params []job.Parameter) (*job.Estimate, error) {
+	queryText, params, err := bindParameters(queryText, params)
+	if err != nil {
+		return nil, err
+	}
+	var plan []byte
+	err = s.db.QueryRowContext(ctx, "EXPLAIN (FORMAT JSON) "+queryText, job.NativeValues(params)...).Scan(&plan)
+	if err != nil {
+		return nil, err
+	}
+	var explain []struct {
+		Plan pgPlanNode `json:"Plan"`
+	}
+	if err := json.Unmarshal(plan, &explain); err != nil {
+		return nil, err
+	}
+	estimate := &job.Estimate{}
+	for _, e := range explain {
+		estimate.Bytes += e.Plan.scanBytes()
+	}
+	return estimate, nil
+}
+
+// bindParameters as $1, $2, ...
+func bindParameters(queryText string, params []job.Parameter) (string, []job.Parameter, error) {
+	return job.BindParameters(queryText, params, func(n int, p job.Parameter) string {
+		return fmt.Sprintf("$%d", n)
+	})
+}
+
+// Job implements job.Job interface for PostgreSQL; query is canceled in the backend when job context is done
+type Job struct {
+	job.BasicJob
+	db             *sql.DB
+	storageObject  storage.StorageObject
+	geometryFormat string
+}
+
+var contextCancelledRe = regexp.MustCompile(`context canceled`)
+
+func (j *Job) write(csvRows chan []string) {
+	storageWriter := j.storageObject.GetWriter(j.GetCtx())
+	resultWriter := j.NewResultWriter(storageWriter)
+	for {
+		csvRow, more := <-csvRows
+		if !more {
+			break
+		}
+		err := resultWriter.Write(csvRow)
+		if err == context.Canceled {
+			break
+		}
+		if j.ResultTruncated(err) {
+			break
+		}
+		if err != nil {
+			j.Logger.Err(err).Send()
+			j.CancelWithError(err)
+			break
+		}
+	}
+	j.close(storageWriter, resultWriter)
+}
+
+func (j *Job) close(storageWriter io.WriteCloser, resultWriter job.ResultWriter) {
+	err := resultWriter.Flush()
+	if closeErr := storageWriter.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if err == context.Canceled {
+			return
+		}
+		if contextCancelledRe.MatchString(err.Error()) {
+			return
+		}
+		j.Logger.Err(err).Send()
+		j.CancelWithError(err)
+		return
+	}
+	resultSize, err := j.storageObject.GetSize(j.GetCtx())
+	if err != nil {
+		j.Logger.Err(err).Send()
+		j.CancelWithError(err)
+		return
+	}
+
+	j.Logger.Debug().Msg("Writing Done")
+	j.Lock()
+	j.ResultSize = *resultSize
+	jobID := j.GetID()
+	j.ResultID = &jobID
+	j.Unlock()
+	j.Status() <- int32(proto.Query_JOB_STATUS_DONE)
+	j.Cancel()
+}
+
+// resultType maps PostgreSQL type to result column type
+func resultType(databaseType string) string {
+	switch databaseType {
+	case "INT2", "INT4", "INT8":
+		return "int64"
+	case "FLOAT4", "FLOAT8":
+		return "float64"
+	case "BOOL":
+		return "bool"
+	}
+	return "utf8"
+}
+
+// formatGeometry converts hex EWKB returned for PostGIS geometry and geography columns;
+// PostGIS types have no fixed OID so value is checked for every column of unknown type
+func (j *Job) formatGeometry(value string) (string, bool) {
+	if len(value) < 10 {
+		return value, false
+	}
+	g, err := geom.ParseHexWKB(value)
+	if err != nil {
+		return value, false
+	}
+	if j.geometryFormat == "GEOJSON" {
+		geojson, err := g.GeoJSON()
+		if err != nil {
+			return value, false
+		}
+		return geojson, true
+	}
+	return g.WKT(), true
+}
+
+func (j *Job) read(rows *sql.Rows, csvRows chan []string) {
+	defer rows.Close()
+	defer close(csvRows)
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		j.Logger.Error().Err(err).Msg("Error getting column types")
+		j.CancelWithError(err)
+		return
+	}
+	j.Status() <- int32(proto.Query_JOB_STATUS_READING_RESULTS)
+
+	columnNames := make([]string, len(columnTypes))
+	unknownType := make([]bool, len(columnTypes))
+	schema := make([]job.Column, len(columnTypes))
+	for i, columnType := range columnTypes {
+		columnNames[i] = columnType.Name()
+		unknownType[i] = columnType.DatabaseTypeName() == ""
+		schema[i] = job.Column{
+			Name:       columnType.Name(),
+			Type:       columnType.DatabaseTypeName(),
+			Nullable:   true,
+			ResultType: resultType(columnType.DatabaseTypeName()),
+		}
+	}
+	j.SetSchema(schema)
+	geometry := make([]bool, len(columnTypes))
+	csvRows <- columnNames
+
+	var totalRows int64
+rowsLoop:
+	for rows.Next() {
+		values := make([]interface{}, len(columnTypes))
+		for i := range columnTypes {
+			values[i] = new(sql.NullString)
+		}
+		if err := rows.Scan(values...); err != nil {
+			j.Logger.Error().Err(err).Msg("Error scanning row")
+			j.CancelWithError(err)
+			return
+		}
+		csvRow := make([]string, len(columnTypes))
+		for i, value := range values {
+			csvRow[i] = value.(*sql.NullString).String
+			if unknownType[i] {
+				var isGeometry bool
+				csvRow[i], isGeometry = j.formatGeometry(csvRow[i])
+				geometry[i] = geometry[i] || isGeometry
+			}
+		}
+		totalRows++
+		select {
+		case csvRows <- csvRow:
+		case <-j.GetReadCtx().Done():
+			break rowsLoop
+		}
+	}
+	if j.GetCtx().Err() != nil {
+		// job was canceled, backend query is canceled by driver
+		return
+	}
+	// backend query is canceled by driver when result is truncated
+	if err := rows.Err(); err != nil && j.GetReadCtx().Err() == nil {
+		j.Logger.Error().Err(err).Msg("Error reading rows")
+		j.CancelWithError(err)
+		return
+	}
+	// schema was shared with job, geometry columns are known only after reading values
+	schema = append([]job.Column(nil), schema...)
+	for i := range schema {
+		if geometry[i] {
+			schema[i].Type = "geometry"
+			schema[i].Geometry = true
+		}
+	}
+	j.SetSchema(schema)
+	if j.GetTruncation() != "" {
+		// total rows are set by result writer
+		return
+	}
+	j.Lock()
+	j.TotalRows = totalRows
+	j.Unlock()
+}
+
+// Run implementation
+func (j *Job) Run(storageObject storage.StorageObject) error {
+	j.Lock()
+	j.storageObject = storageObject
+	j.Unlock()
+	j.Status() <- int32(proto.Query_JOB_STATUS_RUNNING)
+	go func() {
+		queryText, params, err := bindParameters(j.QueryText, j.GetParameters())
+		if err != nil {
+			j.Logger.Warn().Err(err).Msg("Cannot bind query parameters")
+			j.CancelWithError(err)
+			return
+		}
+		rows, err := j.db.QueryContext(j.GetReadCtx(), queryText, job.NativeValues(params)...)
+		if err != nil {
+			if j.GetCtx().Err() != nil {
+				return
+			}
+			j.Logger.Error().Err(err).Msg("Error starting query execution")
+			j.CancelWithError(err)
+			return
+		}
+		csvRows := make(chan []string, 10)
+		go j.write(csvRows)
+		j.read(rows, csvRows)
+	}()
+	return nil
+}
@@ -0,0 +1,103 @@
+package pgjob
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"dekart/src/proto"
+	"dekart/src/server/job"
+	"dekart/src/server/storage"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// ewkbPoint is POINT(1 2) with SRID 4326 as returned by PostGIS
+const ewkbPoint = "0101000020E6100000000000000000F03F0000000000000040"
+
+func newTestJob(geometryFormat string) *Job {
+	j := &Job{
+		BasicJob: job.BasicJob{
+			QueryID: "query",
+			Logger:  zerolog.Nop(),
+		},
+		geometryFormat: geometryFormat,
+	}
+	j.Init()
+	return j
+}
+
+func TestFormatGeometry(t *testing.T) {
+	j := newTestJob("WKT")
+	defer j.Cancel()
+	value, ok := j.formatGeometry(ewkbPoint)
+	require.True(t, ok)
+	require.Equal(t, "POINT (1 2)", value)
+
+	j = newTestJob("GEOJSON")
+	defer j.Cancel()
+	value, ok = j.formatGeometry(ewkbPoint)
+	require.True(t, ok)
+	require.Equal(t, `{"type":"Point","coordinates":[1,2]}`, value)
+
+	for _, text := range []string{"", "01", "not a geometry value", "0101000020E6100000"} {
+		value, ok := j.formatGeometry(text)
+		require.False(t, ok, text)
+		require.Equal(t, text, value)
+	}
+}
+
+func TestResultType(t *testing.T) {
+	require.Equal(t, "int64", resultType("INT8"))
+	require.Equal(t, "float64", resultType("FLOAT8"))
+	require.Equal(t, "bool", resultType("BOOL"))
+	require.Equal(t, "utf8", resultType("NUMERIC"))
+	require.Equal(t, "utf8", resultType(""))
+}
+
+func TestWrite(t *testing.T) {
+	s, err := storage.NewLocalStorageInDir(t.TempDir())
+	require.NoError(t, err)
+	j := newTestJob("WKT")
+	j.storageObject = s.GetObject("result.csv")
+
+	csvRows := make(chan []string, 10)
+	csvRows <- []string{"id", "name", "geom"}
+	csvRows <- []string{"1", "a, quoted", "POINT (1 2)"}
+	csvRows <- []string{"2", "", ""}
+	close(csvRows)
+	go j.write(csvRows)
+
+	require.Equal(t, int32(proto.Query_JOB_STATUS_DONE), <-j.Status())
+	<-j.GetCtx().Done()
+	require.Empty(t, j.Err())
+	require.Equal(t, j.GetID(), *j.GetResultID())
+
+	reader, err := j.storageObject.GetReader(context.Background())
+	require.NoError(t, err)
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "id,name,geom\n1,\"a, quoted\",POINT (1 2)\n2,,\n", string(data))
+	require.Equal(t, int64(len(data)), j.GetResultSize())
+}
+
+func TestScanBytes(t *testing.T) {
+	// EXPLAIN (FORMAT JSON) of join, only relation scans are counted
+	plan := `{
+		"Node Type": "Hash Join", "Plan Rows": 50, "Plan Width": 80,
+		"Plans": [
+			{"Node Type": "Seq Scan", "Relation Name": "points", "Plan Rows": 1000, "Plan Width": 40},
+			{"Node Type": "Hash", "Plan Rows": 10, "Plan Width": 40, "Plans": [
+				{"Node Type": "Index Scan", "Relation Name": "areas", "Plan Rows": 10.6, "Plan Width": 100}
+			]}
+		]
+	}`
+	var node pgPlanNode
+	require.NoError(t, json.Unmarshal([]byte(plan), &node))
+	require.Equal(t, int64(1000*40+10*100), node.scanBytes())
+
+	require.Zero(t, pgPlanNode{PlanRows: 1, PlanWidth: 4}.scanBytes())
+}
@@ -0,0 +1,134 @@
+package report
+
+import (
+	"context"
+	"database/sql"
+	"dekart/src/server/uuid"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+)
+
+// Notifier shares report sequences between server instances
+type Notifier interface {
+	// Notify other instances about new report sequence; must not block caller
+	Notify(reportID string, sequence int64) error
+	// Listen calls receive for notifications from other instances until ctx is done
+	Listen(ctx context.Context, receive func(reportID string, sequence int64)) error
+}
+
+const (
+	postgresNotifyChannel = "dekart_report_streams"
+	// notifications waiting to be sent; when queue is full notifications are dropped
+	notifyQueueSize = 1000
+	notifyTimeout   = 5 * time.Second
+)
+
+// errNotifyQueueFull is returned by Notify when notifications are not sent fast enough
+var errNotifyQueueFull = errors.New("report streams notification queue is full")
+
+// PostgresNotifier implements Notifier with Postgres LISTEN/NOTIFY
+type PostgresNotifier struct {
+	db         *sql.DB
+	url        string
+	instanceID string
+	queue      chan notification
+}
+
+// NewPostgresNotifier sends notifications with db; url is used for dedicated listener connection
+func NewPostgresNotifier(db *sql.DB, url string) *PostgresNotifier {
+	return &PostgresNotifier{
+		db:         db,
+		url:        url,
+		instanceID: uuid.GetUUID(),
+		queue:      make(chan notification, notifyQueueSize),
+	}
+}
+
+type notification struct {
+	InstanceID string `json:"instance_id"`
+	ReportID   string `json:"report_id"`
+	Sequence   int64  `json:"sequence"`
+}
+
+// Notify implements Notifier; notification is queued and sent by Listen
+func (n *PostgresNotifier) Notify(reportID string, sequence int64) error {
+	select {
+	case n.queue <- notification{
+		InstanceID: n.instanceID,
+		ReportID:   reportID,
+		Sequence:   sequence,
+	}:
+		return nil
+	default:
+		return errNotifyQueueFull
+	}
+}
+
+// send queued notifications until ctx is done
+func (n *PostgresNotifier) send(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message := <-n.queue:
+			payload, err := json.Marshal(message)
+			if err != nil {
+				log.Err(err).Send()
+				continue
+			}
+			notifyCtx, cancel := context.WithTimeout(ctx, notifyTimeout)
+			_, err = n.db.ExecContext(notifyCtx, "select pg_notify($1, $2)", postgresNotifyChannel, string(payload))
+			cancel()
+			if err != nil && ctx.Err() == nil {
+				log.Err(err).Str("reportID", message.ReportID).Msg("Cannot notify about report update")
+			}
+		}
+	}
+}
+
+// Listen implements Notifier; queued notifications are sent while listening
+func (n *PostgresNotifier) Listen(ctx context.Context, receive func(reportID string, sequence int64)) error {
+	go n.send(ctx)
+	listener := pq.NewListener(n.url, 10*time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Warn().Err(err).Msg("Report streams listener connection error")
+		}
+	})
+	defer listener.Close()
+	err := listener.Listen(postgresNotifyChannel)
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case pgNotification := <-listener.Notify:
+			if pgNotification == nil {
+				// connection was re-established, notifications sent meanwhile are lost
+				log.Info().Msg("Report streams listener reconnected")
+				continue
+			}
+			var message notification
+			err := json.Unmarshal([]byte(pgNotification.Extra), &message)
+			if err != nil {
+				log.Err(err).Str("payload", pgNotification.Extra).Msg("Cannot parse report stream notification")
+				continue
+			}
+			if message.InstanceID == n.instanceID {
+				continue
+			}
+			receive(message.ReportID, message.Sequence)
+		case <-time.After(90 * time.Second):
+			go func() {
+				if err := listener.Ping(); err != nil {
+					log.Warn().Err(err).Msg("Report streams listener ping failed")
+				}
+			}()
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresNotifierDoesNotBlock(t *testing.T) {
+	// notifications are only queued until Listen starts sending them
+	n := NewPostgresNotifier(nil, "")
+	for i := 0; i < notifyQueueSize; i++ {
+		require.NoError(t, n.Notify("report", int64(i)))
+	}
+	require.Equal(t, errNotifyQueueFull, n.Notify("report", notifyQueueSize))
+
+	message := <-n.queue
+	require.Equal(t, "report", message.ReportID)
+	require.Equal(t, int64(0), message.Sequence)
+	require.Equal(t, n.instanceID, message.InstanceID)
+}
@@ -1,7 +1,11 @@
 package report
 
 import (
+	"context"
 	"sync"
+	"time"
+
+	"dekart/src/server/metrics"
 
 	"github.com/rs/zerolog/log"
 )
@@ -11,6 +15,7 @@ type Streams struct {
 	channels map[string]map[string]chan int64
 	sequence map[string]int64
 	mutex    sync.Mutex
+	notifier Notifier
 }
 
 // NewStreams creates new Streams struct
@@ -53,6 +58,7 @@ func (s *Streams) Register(reportID string, streamID string, sequence int64) cha
 	}
 	ch := make(chan int64)
 	streamMap[streamID] = ch
+	metrics.ReportStreamSubscribers.Inc()
 	if currentSequence > sequence {
 		log.Debug().Str("reportID", reportID).Str("streamID", streamID).Int64("sequence", sequence).Int64("currentSequence", currentSequence).Msgf("Update outdated subscription")
 		go func() {
@@ -70,7 +76,10 @@ func (s *Streams) Deregister(reportID string, streamID string) {
 	if !ok {
 		log.Fatal().Msgf("reportId %s does not exist", reportID)
 	}
-	delete(streamMap, streamID)
+	if _, ok := streamMap[streamID]; ok {
+		delete(streamMap, streamID)
+		metrics.ReportStreamSubscribers.Dec()
+	}
 }
 
 func (s *Streams) PingAll(reportIDs []string) {
@@ -79,27 +88,70 @@ func (s *Streams) PingAll(reportIDs []string) {
 	}
 }
 
+// nextSequence of report update; sequences are microseconds of update time so that sequence received
+// from one instance can be compared with sequence of another instance (and still fit JS number)
+func nextSequence(current int64) int64 {
+	sequence := time.Now().UnixNano() / int64(time.Microsecond)
+	if sequence <= current {
+		return current + 1
+	}
+	return sequence
+}
+
 // Ping about report update
 func (s *Streams) Ping(reportID string) {
 	log.Debug().Str("reportID", reportID).Msgf("Ping")
 	s.mutex.Lock()
-	defer s.mutex.Unlock()
+	sequences := make(map[string]int64)
 	for _, rid := range []string{reportID, All} {
-		sequence, ok := s.sequence[rid]
-		if !ok {
-			//initial sequence in register is sequence=1
-			sequence = 1
-		}
-		s.sequence[rid] = sequence + 1
-		streamMap, ok := s.channels[rid]
-		if !ok {
-			continue
-		}
-		for _, ch := range streamMap {
-			log.Debug().Int64("sequence", s.sequence[rid]).Str("rid", rid).Msgf("Update subscriber")
-			go func(ch chan int64, rid string) {
-				ch <- s.sequence[rid]
-			}(ch, rid)
+		sequence := nextSequence(s.sequence[rid])
+		s.update(rid, sequence)
+		sequences[rid] = sequence
+	}
+	notifier := s.notifier
+	s.mutex.Unlock()
+	if notifier == nil {
+		return
+	}
+	for rid, sequence := range sequences {
+		if err := notifier.Notify(rid, sequence); err != nil {
+			log.Err(err).Str("reportID", rid).Msg("Cannot notify about report update")
 		}
 	}
 }
+
+// update sequence and send it to subscribers; mutex must be locked
+func (s *Streams) update(rid string, sequence int64) {
+	s.sequence[rid] = sequence
+	streamMap, ok := s.channels[rid]
+	if !ok {
+		return
+	}
+	for _, ch := range streamMap {
+		log.Debug().Int64("sequence", sequence).Str("rid", rid).Msgf("Update subscriber")
+		go func(ch chan int64) {
+			ch <- sequence
+		}(ch)
+	}
+}
+
+// receive update from another instance
+func (s *Streams) receive(rid string, sequence int64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	currentSequence := s.sequence[rid]
+	// concurrent pings on different instances may produce same sequence,
+	// subscribers still have to be updated so sequence is incremented locally
+	if sequence <= currentSequence {
+		sequence = currentSequence + 1
+	}
+	s.update(rid, sequence)
+}
+
+// Listen shares report updates with other instances using notifier until ctx is done
+func (s *Streams) Listen(ctx context.Context, notifier Notifier) error {
+	s.mutex.Lock()
+	s.notifier = notifier
+	s.mutex.Unlock()
+	return notifier.Listen(ctx, s.receive)
+}
@@ -0,0 +1,98 @@
+package report
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// receiveSequence from subscriber channel or 0 when nothing is received within timeout
+func receiveSequence(ch chan int64, timeout time.Duration) int64 {
+	select {
+	case sequence := <-ch:
+		return sequence
+	case <-time.After(timeout):
+		return 0
+	}
+}
+
+func TestStreamsPing(t *testing.T) {
+	s := NewStreams()
+	// initial sequence of client is 0, it always receives update
+	ch := s.Register("report", "stream1", 0)
+	first := receiveSequence(ch, time.Second)
+	require.Greater(t, first, int64(0))
+	s.Deregister("report", "stream1")
+
+	ch = s.Register("report", "stream2", first)
+	require.Zero(t, receiveSequence(ch, 50*time.Millisecond))
+	s.Ping("report")
+	second := receiveSequence(ch, time.Second)
+	require.Greater(t, second, first)
+	s.Deregister("report", "stream2")
+
+	// subscriber with outdated sequence is updated right away
+	ch = s.Register("report", "stream3", first)
+	require.Equal(t, second, receiveSequence(ch, time.Second))
+	s.Deregister("report", "stream3")
+
+	// pings in the same millisecond still increase sequence
+	all := s.Register(All, "all", second)
+	s.Ping("other")
+	s.Ping("other")
+	third := receiveSequence(all, time.Second)
+	fourth := receiveSequence(all, time.Second)
+	require.NotEqual(t, third, fourth)
+	s.Deregister(All, "all")
+}
+
+// testNotifier delivers notifications to streams of other instances
+type testNotifier struct {
+	receivers []func(reportID string, sequence int64)
+}
+
+func (n *testNotifier) Notify(reportID string, sequence int64) error {
+	for _, receive := range n.receivers {
+		receive(reportID, sequence)
+	}
+	return nil
+}
+
+func (n *testNotifier) Listen(ctx context.Context, receive func(reportID string, sequence int64)) error {
+	return nil
+}
+
+func TestStreamsAcrossInstances(t *testing.T) {
+	a := NewStreams()
+	a.Ping("report")
+	a.Ping("report")
+	a.Ping("report")
+	ch := a.Register("report", "stream", 0)
+	seen := receiveSequence(ch, time.Second)
+	a.Deregister("report", "stream")
+
+	// b started after report was updated on a and did not receive those updates
+	b := NewStreams()
+	a.notifier = &testNotifier{receivers: []func(string, int64){b.receive}}
+	b.notifier = &testNotifier{receivers: []func(string, int64){a.receive}}
+
+	// report changed on b while client was reconnecting to b
+	time.Sleep(2 * time.Millisecond)
+	b.Ping("report")
+	ch = b.Register("report", "stream", seen)
+	require.Greater(t, receiveSequence(ch, time.Second), seen)
+	b.Deregister("report", "stream")
+}
+
+func TestStreamsReceive(t *testing.T) {
+	s := NewStreams()
+	ch := s.Register("report", "stream", 100)
+	s.receive("report", 200)
+	require.Equal(t, int64(200), receiveSequence(ch, time.Second))
+	// sequence of other instance which is not newer still updates subscribers
+	s.receive("report", 150)
+	require.Equal(t, int64(201), receiveSequence(ch, time.Second))
+	s.Deregister("report", "stream")
+}
@@ -6,10 +6,9 @@ import (
 	"dekart/src/proto"
 	"dekart/src/server/job"
 	"dekart/src/server/storage"
-	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"io"
-	"os"
 	"regexp"
 	"sync"
 
@@ -26,39 +25,47 @@ type Job struct {
 
 type Store struct {
 	job.BasicStore
+	config job.Config
 }
 
-func NewStore() *Store {
-	store := &Store{}
+func NewStore(config job.Config) *Store {
+	store := &Store{
+		config: config,
+	}
 	return store
 }
 
 func (j *Job) write(csvRows chan []string) {
 	storageWriter := j.storageObject.GetWriter(j.GetCtx())
-	csvWriter := csv.NewWriter(storageWriter)
+	resultWriter := j.NewResultWriter(storageWriter)
 	for {
 		csvRow, more := <-csvRows
 		if !more {
 			break
 		}
-		err := csvWriter.Write(csvRow)
+		err := resultWriter.Write(csvRow)
 		if err == context.Canceled {
 			break
 		}
+		if j.ResultTruncated(err) {
+			break
+		}
 		if err != nil {
 			j.Logger.Err(err).Send()
 			j.CancelWithError(err)
 			break
 		}
 	}
-	j.close(storageWriter, csvWriter)
+	j.close(storageWriter, resultWriter)
 }
 
 var contextCancelledRe = regexp.MustCompile(`context canceled`)
 
-func (j *Job) close(storageWriter io.WriteCloser, csvWriter *csv.Writer) {
-	csvWriter.Flush()
-	err := storageWriter.Close()
+func (j *Job) close(storageWriter io.WriteCloser, resultWriter job.ResultWriter) {
+	err := resultWriter.Flush()
+	if closeErr := storageWriter.Close(); err == nil {
+		err = closeErr
+	}
 	if err != nil {
 		// maybe we should not close when context is canceled in job.write()
 		if err == context.Canceled {
@@ -88,6 +95,21 @@ func (j *Job) close(storageWriter io.WriteCloser, csvWriter *csv.Writer) {
 	j.Cancel()
 }
 
+// resultType maps Snowflake type to result column type; NUMBER with scale is kept as string to keep precision
+func resultType(columnType *sql.ColumnType) string {
+	switch columnType.DatabaseTypeName() {
+	case "FIXED":
+		if precision, scale, ok := columnType.DecimalSize(); ok && scale == 0 && precision <= 18 {
+			return "int64"
+		}
+	case "REAL":
+		return "float64"
+	case "BOOLEAN":
+		return "bool"
+	}
+	return "utf8"
+}
+
 func (j *Job) fetchQueryMetadata(queryIDChan chan string, resultsReady chan bool, wg *sync.WaitGroup) {
 	ctx := j.GetCtx()
 	defer wg.Done()
@@ -129,9 +151,17 @@ func (j *Job) Run(storageObject storage.StorageObject) error {
 	metadataWg.Add(1)
 	go j.fetchQueryMetadata(queryIDChan, resultsReady, metadataWg)
 	j.Status() <- int32(proto.Query_JOB_STATUS_RUNNING)
+	queryText, params, err := bindParameters(j.QueryText, j.GetParameters())
+	if err != nil {
+		j.Logger.Warn().Err(err).Msg("Cannot bind query parameters")
+		j.CancelWithError(err)
+		return err
+	}
+	// query is canceled when result is truncated
 	rows, err := j.snowflakeDb.QueryContext(
-		sf.WithQueryIDChan(j.GetCtx(), queryIDChan),
-		j.QueryText,
+		sf.WithQueryIDChan(j.GetReadCtx(), queryIDChan),
+		queryText,
+		job.NativeValues(params)...,
 	)
 	if err != nil {
 		j.Logger.Error().Err(err).Msg("Error starting query execution")
@@ -145,6 +175,7 @@ func (j *Job) Run(storageObject storage.StorageObject) error {
 
 	firstRow := true
 
+rowsLoop:
 	for rows.Next() {
 		columnTypes, err := rows.ColumnTypes()
 		if err != nil {
@@ -157,9 +188,19 @@ func (j *Job) Run(storageObject storage.StorageObject) error {
 			resultsReady <- true
 			j.Status() <- int32(proto.Query_JOB_STATUS_READING_RESULTS)
 			columnNames := make([]string, len(columnTypes))
+			schema := make([]job.Column, len(columnTypes))
 			for i, columnType := range columnTypes {
 				columnNames[i] = columnType.Name()
+				nullable, ok := columnType.Nullable()
+				schema[i] = job.Column{
+					Name:       columnType.Name(),
+					Type:       columnType.DatabaseTypeName(),
+					Nullable:   nullable || !ok,
+					Geometry:   columnType.DatabaseTypeName() == "GEOGRAPHY" || columnType.DatabaseTypeName() == "GEOMETRY",
+					ResultType: resultType(columnType),
+				}
 			}
+			j.SetSchema(schema)
 			csvRows <- columnNames
 		}
 
@@ -179,20 +220,73 @@ func (j *Job) Run(storageObject storage.StorageObject) error {
 				return fmt.Errorf("incorrect type of data: %T", x)
 			}
 		}
-		csvRows <- csvRow
+		select {
+		case csvRows <- csvRow:
+		case <-j.GetReadCtx().Done():
+			break rowsLoop
+		}
 	}
 	metadataWg.Wait() // do not close context until metadata is fetched
 	close(csvRows)    //better to close in defer?
 	return nil
 }
 
-func (s *Store) Create(reportID string, queryID string, queryText string) (job.Job, chan int32, error) {
-	dataSourceName := fmt.Sprintf(
+// bindParameters as ? placeholders
+func bindParameters(queryText string, params []job.Parameter) (string, []job.Parameter, error) {
+	return job.BindParameters(queryText, params, func(n int, p job.Parameter) string {
+		return "?"
+	})
+}
+
+// dataSourceName from DEKART_SNOWFLAKE_* variables
+func (s *Store) dataSourceName() string {
+	return fmt.Sprintf(
 		"%s:%s@%s",
-		os.Getenv("DEKART_SNOWFLAKE_USER"),
-		os.Getenv("DEKART_SNOWFLAKE_PASSWORD"),
-		os.Getenv("DEKART_SNOWFLAKE_ACCOUNT_ID"),
+		s.config.Getenv("DEKART_SNOWFLAKE_USER"),
+		s.config.Getenv("DEKART_SNOWFLAKE_PASSWORD"),
+		s.config.Getenv("DEKART_SNOWFLAKE_ACCOUNT_ID"),
 	)
+}
+
+// Estimate bytes scanned by query from partitions assigned in EXPLAIN plan
+func (s *Store) Estimate(ctx context.Context, queryText string, params []job.Parameter) (*job.Estimate, error) {
+	queryText, params, err := bindParameters(queryText, params)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("snowflake", s.dataSourceName())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	rows, err := db.QueryContext(ctx, "EXPLAIN USING JSON "+queryText, job.NativeValues(params)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("empty query plan")
+	}
+	var plan string
+	if err := rows.Scan(&plan); err != nil {
+		return nil, err
+	}
+	var explain struct {
+		GlobalStats struct {
+			BytesAssigned int64 `json:"bytesAssigned"`
+		} `json:"GlobalStats"`
+	}
+	if err := json.Unmarshal([]byte(plan), &explain); err != nil {
+		return nil, err
+	}
+	return &job.Estimate{Bytes: explain.GlobalStats.BytesAssigned}, nil
+}
+
+func (s *Store) Create(reportID string, queryID string, queryText string) (job.Job, chan int32, error) {
+	dataSourceName := s.dataSourceName()
 	db, err := sql.Open("snowflake", dataSourceName)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to connect to snowflake")
@@ -0,0 +1,245 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"dekart/src/server/metrics"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// LocalStorage implements Storage interface for a directory on local filesystem
+type LocalStorage struct {
+	dir    string
+	logger zerolog.Logger
+}
+
+// NewLocalStorage creates LocalStorage in DEKART_LOCAL_STORAGE_PATH
+func NewLocalStorage() LocalStorage {
+	dir := os.Getenv("DEKART_LOCAL_STORAGE_PATH")
+	if dir == "" {
+		log.Fatal().Msg("DEKART_LOCAL_STORAGE_PATH is not set")
+	}
+	storage, err := NewLocalStorageInDir(dir)
+	if err != nil {
+		log.Fatal().Err(err).Str("DEKART_LOCAL_STORAGE_PATH", dir).Msg("cannot create local storage")
+	}
+	return storage
+}
+
+// NewLocalStorageInDir creates LocalStorage in dir, dir is created if not exists
+func NewLocalStorageInDir(dir string) (LocalStorage, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return LocalStorage{}, err
+	}
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return LocalStorage{}, err
+	}
+	return LocalStorage{
+		dir:    dir,
+		logger: log.With().Str("DEKART_LOCAL_STORAGE_PATH", dir).Logger(),
+	}, nil
+}
+
+// Ping checks that storage directory exists
+func (s LocalStorage) Ping(ctx context.Context) error {
+	info, err := os.Stat(s.dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", s.dir)
+	}
+	return nil
+}
+
+func (s LocalStorage) GetObject(name string) StorageObject {
+	// object names are flat, cleaning prevents escaping storage directory
+	path := filepath.Join(s.dir, filepath.Clean("/"+name))
+	return LocalStorageObject{
+		dir:    s.dir,
+		path:   path,
+		logger: s.logger.With().Str("LocalStorageObject", name).Logger(),
+	}
+}
+
+// LocalStorageObject implements StorageObject interface for a file on local filesystem
+type LocalStorageObject struct {
+	dir    string
+	path   string
+	logger zerolog.Logger
+}
+
+func (o LocalStorageObject) GetReader(ctx context.Context) (io.ReadCloser, error) {
+	return traceRead(ctx, o.path, func(ctx context.Context) (io.ReadCloser, error) {
+		file, err := os.Open(o.path)
+		if err != nil {
+			o.logger.Error().Err(err).Msg("error opening object")
+			return nil, err
+		}
+		return file, nil
+	})
+}
+
+// GetWriter returns writer to temporary file which is renamed to object on Close,
+// so readers never see partially written objects
+func (o LocalStorageObject) GetWriter(ctx context.Context) io.WriteCloser {
+	return traceWrite(ctx, o.path, func(ctx context.Context) io.WriteCloser {
+		return o.newWriter(ctx)
+	})
+}
+
+func (o LocalStorageObject) newWriter(ctx context.Context) *LocalWriter {
+	file, err := os.CreateTemp(o.dir, fmt.Sprintf(".%s.*.tmp", filepath.Base(o.path)))
+	if err != nil {
+		o.logger.Error().Err(err).Msg("error creating temporary file")
+	}
+	return &LocalWriter{
+		ctx:    ctx,
+		file:   file,
+		err:    err,
+		path:   o.path,
+		logger: o.logger,
+	}
+}
+
+func (o LocalStorageObject) GetCreatedAt(ctx context.Context) (*time.Time, error) {
+	info, err := os.Stat(o.path)
+	if err != nil {
+		o.logger.Error().Err(err).Msg("error getting file info")
+		return nil, err
+	}
+	// objects are written once with rename, so modification time is creation time
+	createdAt := info.ModTime()
+	return &createdAt, nil
+}
+
+func (o LocalStorageObject) GetSize(ctx context.Context) (*int64, error) {
+	info, err := os.Stat(o.path)
+	if err != nil {
+		o.logger.Error().Err(err).Msg("error getting file info")
+		return nil, err
+	}
+	size := info.Size()
+	return &size, nil
+}
+
+func (o LocalStorageObject) Delete(ctx context.Context) error {
+	err := os.Remove(o.path)
+	if err != nil {
+		o.logger.Error().Err(err).Msg("error deleting object")
+	}
+	return err
+}
+
+// CopyFromS3 copies source into object; besides s3:// URLs local file:// URLs are accepted
+func (o LocalStorageObject) CopyFromS3(ctx context.Context, source string) error {
+	u, err := url.Parse(source)
+	if err != nil {
+		o.logger.Error().Str("source", source).Err(err).Msg("Error parsing source URL")
+		return err
+	}
+	var reader io.ReadCloser
+	switch u.Scheme {
+	case "s3":
+		ses := session.Must(session.NewSession(aws.NewConfig().WithMaxRetries(3).WithS3ForcePathStyle(true)))
+		output, err := s3.New(ses).GetObjectWithContext(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(u.Hostname()),
+			Key:    aws.String(strings.TrimPrefix(u.Path, "/")),
+		})
+		if err != nil {
+			o.logger.Error().Str("source", source).Err(err).Msg("Error getting object from S3")
+			return err
+		}
+		reader = output.Body
+	case "file", "":
+		reader, err = os.Open(u.Path)
+		if err != nil {
+			o.logger.Error().Str("source", source).Err(err).Msg("Error opening source file")
+			return err
+		}
+	default:
+		err = fmt.Errorf("unsupported source scheme %s", u.Scheme)
+		o.logger.Error().Str("source", source).Err(err).Send()
+		return err
+	}
+	defer reader.Close()
+
+	writer := o.newWriter(ctx)
+	if _, err := io.Copy(metrics.CountWrite(writer), reader); err != nil {
+		o.logger.Error().Str("source", source).Err(err).Msg("Error copying source")
+		writer.abort()
+		return err
+	}
+	return writer.Close()
+}
+
+// LocalWriter writes object to temporary file and renames it on Close
+type LocalWriter struct {
+	ctx    context.Context
+	file   *os.File
+	err    error
+	path   string
+	logger zerolog.Logger
+}
+
+func (w *LocalWriter) Write(p []byte) (n int, err error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	if err := w.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return w.file.Write(p)
+}
+
+func (w *LocalWriter) abort() {
+	if w.file == nil {
+		return
+	}
+	w.file.Close()
+	if err := os.Remove(w.file.Name()); err != nil {
+		w.logger.Err(err).Msg("error removing temporary file")
+	}
+}
+
+func (w *LocalWriter) Close() error {
+	if w.err != nil {
+		w.abort()
+		return w.err
+	}
+	// same as cloud writers, object is not created when context is canceled
+	if err := w.ctx.Err(); err != nil {
+		w.abort()
+		return err
+	}
+	if err := w.file.Sync(); err != nil {
+		w.logger.Err(err).Msg("error syncing temporary file")
+		w.abort()
+		return err
+	}
+	if err := w.file.Close(); err != nil {
+		w.logger.Err(err).Msg("error closing temporary file")
+		os.Remove(w.file.Name())
+		return err
+	}
+	if err := os.Rename(w.file.Name(), w.path); err != nil {
+		w.logger.Err(err).Msg("error renaming temporary file")
+		os.Remove(w.file.Name())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readObject(t *testing.T, obj StorageObject) string {
+	reader, err := obj.GetReader(context.Background())
+	require.NoError(t, err)
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := NewLocalStorageInDir(filepath.Join(dir, "bucket"))
+	require.NoError(t, err)
+
+	t.Run("write and read", func(t *testing.T) {
+		obj := s.GetObject("result.csv")
+		writer := obj.GetWriter(ctx)
+		_, err := writer.Write([]byte("a,b\n1,2\n"))
+		require.NoError(t, err)
+
+		// object is not visible before writer is closed
+		_, err = obj.GetSize(ctx)
+		require.Error(t, err)
+
+		require.NoError(t, writer.Close())
+		require.Equal(t, "a,b\n1,2\n", readObject(t, obj))
+
+		size, err := obj.GetSize(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(8), *size)
+
+		createdAt, err := obj.GetCreatedAt(ctx)
+		require.NoError(t, err)
+		require.False(t, createdAt.IsZero())
+	})
+
+	t.Run("canceled context discards object", func(t *testing.T) {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		obj := s.GetObject("canceled.csv")
+		writer := obj.GetWriter(cancelCtx)
+		_, err := writer.Write([]byte("a,b\n"))
+		require.NoError(t, err)
+		cancel()
+		require.ErrorIs(t, writer.Close(), context.Canceled)
+		_, err = obj.GetSize(ctx)
+		require.Error(t, err)
+
+		entries, err := os.ReadDir(filepath.Join(dir, "bucket"))
+		require.NoError(t, err)
+		for _, entry := range entries {
+			require.NotEqual(t, ".tmp", filepath.Ext(entry.Name()))
+		}
+	})
+
+	t.Run("object name cannot escape directory", func(t *testing.T) {
+		obj := s.GetObject("../escaped.csv")
+		writer := obj.GetWriter(ctx)
+		_, err := writer.Write([]byte("x"))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		_, err = os.Stat(filepath.Join(dir, "escaped.csv"))
+		require.True(t, os.IsNotExist(err))
+		require.Equal(t, "x", readObject(t, s.GetObject("escaped.csv")))
+	})
+
+	t.Run("ping", func(t *testing.T) {
+		require.NoError(t, s.Ping(ctx))
+		removed, err := NewLocalStorageInDir(filepath.Join(dir, "bucket-removed"))
+		require.NoError(t, err)
+		require.NoError(t, os.Remove(filepath.Join(dir, "bucket-removed")))
+		require.Error(t, removed.Ping(ctx))
+	})
+
+	t.Run("copy from file", func(t *testing.T) {
+		source := filepath.Join(dir, "athena-result.csv")
+		require.NoError(t, os.WriteFile(source, []byte("id\n1\n"), 0644))
+		obj := s.GetObject("copy.csv")
+		require.NoError(t, obj.CopyFromS3(ctx, "file://"+source))
+		require.Equal(t, "id\n1\n", readObject(t, obj))
+	})
+}
+
+func TestLocalMultipartUpload(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewLocalStorageInDir(t.TempDir())
+	require.NoError(t, err)
+
+	uploadID, err := CreateMultipartUpload(ctx, s, "upload.csv")
+	require.NoError(t, err)
+	// parts may arrive in any order and by different requests
+	upload := GetMultipartUpload(s, "upload.csv", uploadID)
+	require.NoError(t, upload.UploadPart(ctx, 2, strings.NewReader("1,2\n")))
+	require.NoError(t, GetMultipartUpload(s, "upload.csv", uploadID).UploadPart(ctx, 1, strings.NewReader("a,b\n")))
+
+	_, err = s.GetObject("upload.csv").GetSize(ctx)
+	require.Error(t, err, "object is created on complete")
+
+	require.NoError(t, upload.Complete(ctx, 2))
+	require.Equal(t, "a,b\n1,2\n", readObject(t, s.GetObject("upload.csv")))
+	entries, err := os.ReadDir(s.dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "parts are deleted")
+
+	uploadID, err = CreateMultipartUpload(ctx, s, "aborted.csv")
+	require.NoError(t, err)
+	upload = GetMultipartUpload(s, "aborted.csv", uploadID)
+	require.NoError(t, upload.UploadPart(ctx, 1, strings.NewReader("a,b\n")))
+	require.Error(t, upload.Complete(ctx, 2), "missing part")
+	require.NoError(t, upload.Abort(ctx, 2))
+	entries, err = os.ReadDir(s.dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
@@ -0,0 +1,195 @@
+package storage
+
+import (
+	"context"
+	"dekart/src/server/uuid"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// MultipartUpload assembles object from parts uploaded independently, for example by separate HTTP requests;
+// part numbers start from 1
+type MultipartUpload interface {
+	UploadPart(ctx context.Context, partNumber int, body io.ReadSeeker) error
+	Complete(ctx context.Context, parts int) error
+	Abort(ctx context.Context, parts int) error
+}
+
+// MultipartStorage is implemented by storages with native multipart uploads
+type MultipartStorage interface {
+	CreateMultipartUpload(ctx context.Context, object string) (string, error)
+	GetMultipartUpload(object string, uploadID string) MultipartUpload
+}
+
+// CreateMultipartUpload of object and returns upload id which is used to continue upload later
+func CreateMultipartUpload(ctx context.Context, s Storage, object string) (string, error) {
+	if ms, ok := s.(MultipartStorage); ok {
+		return ms.CreateMultipartUpload(ctx, object)
+	}
+	return uuid.GetUUID(), nil
+}
+
+// GetMultipartUpload created with CreateMultipartUpload;
+// storages without native multipart uploads keep parts as separate objects until upload is completed
+func GetMultipartUpload(s Storage, object string, uploadID string) MultipartUpload {
+	if ms, ok := s.(MultipartStorage); ok {
+		return ms.GetMultipartUpload(object, uploadID)
+	}
+	return partsUpload{
+		storage:  s,
+		object:   object,
+		uploadID: uploadID,
+	}
+}
+
+// partsUpload stores each part as object and concatenates them on Complete
+type partsUpload struct {
+	storage  Storage
+	object   string
+	uploadID string
+}
+
+func (u partsUpload) part(partNumber int) StorageObject {
+	return u.storage.GetObject(fmt.Sprintf("%s.%s.part%d", u.object, u.uploadID, partNumber))
+}
+
+func (u partsUpload) UploadPart(ctx context.Context, partNumber int, body io.ReadSeeker) error {
+	// writers do not create object when context is canceled
+	writeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	writer := u.part(partNumber).GetWriter(writeCtx)
+	if _, err := io.Copy(writer, body); err != nil {
+		cancel()
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+func (u partsUpload) Complete(ctx context.Context, parts int) error {
+	writeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	writer := u.storage.GetObject(u.object).GetWriter(writeCtx)
+	for i := 1; i <= parts; i++ {
+		reader, err := u.part(i).GetReader(ctx)
+		if err != nil {
+			cancel()
+			writer.Close()
+			return err
+		}
+		_, err = io.Copy(writer, reader)
+		reader.Close()
+		if err != nil {
+			cancel()
+			writer.Close()
+			return err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return u.Abort(ctx, parts)
+}
+
+// Abort deletes uploaded parts, errors of parts which were never uploaded are ignored
+func (u partsUpload) Abort(ctx context.Context, parts int) error {
+	for i := 1; i <= parts; i++ {
+		u.part(i).Delete(ctx)
+	}
+	return nil
+}
+
+func (s S3Storage) CreateMultipartUpload(ctx context.Context, object string) (string, error) {
+	out, err := s.client.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(object),
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Str("name", object).Msg("error creating multipart upload")
+		return "", err
+	}
+	return *out.UploadId, nil
+}
+
+func (s S3Storage) GetMultipartUpload(object string, uploadID string) MultipartUpload {
+	return S3MultipartUpload{
+		S3Storage: s,
+		name:      object,
+		uploadID:  uploadID,
+	}
+}
+
+// S3MultipartUpload implements MultipartUpload with S3 multipart upload; all parts but last must be at least 5 MiB
+type S3MultipartUpload struct {
+	S3Storage
+	name     string
+	uploadID string
+}
+
+func (u S3MultipartUpload) UploadPart(ctx context.Context, partNumber int, body io.ReadSeeker) error {
+	_, err := u.client.UploadPartWithContext(ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(u.bucketName),
+		Key:        aws.String(u.name),
+		UploadId:   aws.String(u.uploadID),
+		PartNumber: aws.Int64(int64(partNumber)),
+		Body:       body,
+	})
+	if err != nil {
+		u.logger.Error().Err(err).Str("name", u.name).Int("part", partNumber).Msg("error uploading part")
+	}
+	return err
+}
+
+// Complete lists uploaded parts from S3, so ETags of parts do not need to be kept between requests
+func (u S3MultipartUpload) Complete(ctx context.Context, parts int) error {
+	completed := make([]*s3.CompletedPart, 0, parts)
+	err := u.client.ListPartsPagesWithContext(ctx, &s3.ListPartsInput{
+		Bucket:   aws.String(u.bucketName),
+		Key:      aws.String(u.name),
+		UploadId: aws.String(u.uploadID),
+	}, func(page *s3.ListPartsOutput, lastPage bool) bool {
+		for _, part := range page.Parts {
+			completed = append(completed, &s3.CompletedPart{
+				ETag:       part.ETag,
+				PartNumber: part.PartNumber,
+			})
+		}
+		return true
+	})
+	if err != nil {
+		u.logger.Error().Err(err).Str("name", u.name).Msg("error listing parts")
+		return err
+	}
+	if len(completed) != parts {
+		return fmt.Errorf("expected %d parts, uploaded %d", parts, len(completed))
+	}
+	sort.Slice(completed, func(i, j int) bool {
+		return *completed[i].PartNumber < *completed[j].PartNumber
+	})
+	_, err = u.client.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(u.bucketName),
+		Key:             aws.String(u.name),
+		UploadId:        aws.String(u.uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completed},
+	})
+	if err != nil {
+		u.logger.Error().Err(err).Str("name", u.name).Msg("error completing multipart upload")
+	}
+	return err
+}
+
+func (u S3MultipartUpload) Abort(ctx context.Context, parts int) error {
+	_, err := u.client.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(u.bucketName),
+		Key:      aws.String(u.name),
+		UploadId: aws.String(u.uploadID),
+	})
+	if err != nil {
+		u.logger.Error().Err(err).Str("name", u.name).Msg("error aborting multipart upload")
+	}
+	return err
+}
@@ -9,6 +9,7 @@ import (
 
 	"cloud.google.com/go/storage"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
@@ -23,12 +24,18 @@ type StorageObject interface {
 	GetCreatedAt(context.Context) (*time.Time, error)
 	GetSize(context.Context) (*int64, error)
 	CopyFromS3(ctx context.Context, source string) error
+	Delete(ctx context.Context) error
 }
 
 type Storage interface {
 	GetObject(string) StorageObject
+	// Ping checks that storage is reachable with given credentials
+	Ping(ctx context.Context) error
 }
 
+// pingObject is looked up by Ping, it does not need to exist
+const pingObject = "dekart-ping"
+
 //GoogleCloudStorage implements Storage interface for Google Cloud Storage
 type GoogleCloudStorage struct {
 	bucket *storage.BucketHandle
@@ -60,6 +67,15 @@ func (s GoogleCloudStorage) GetObject(object string) StorageObject {
 	}
 }
 
+// Ping requests metadata of object, missing object means bucket is reachable
+func (s GoogleCloudStorage) Ping(ctx context.Context) error {
+	_, err := s.bucket.Object(pingObject).Attrs(ctx)
+	if err == storage.ErrObjectNotExist {
+		return nil
+	}
+	return err
+}
+
 //GoogleCloudStorageObject implements StorageObject interface for Google Cloud Storage
 type GoogleCloudStorageObject struct {
 	obj    *storage.ObjectHandle
@@ -72,13 +88,17 @@ func (o GoogleCloudStorageObject) CopyFromS3(ctx context.Context, source string)
 }
 
 func (o GoogleCloudStorageObject) GetWriter(ctx context.Context) io.WriteCloser {
-	writer := o.obj.NewWriter(ctx)
-	writer.ChunkSize = 0
-	return writer
+	return traceWrite(ctx, o.obj.ObjectName(), func(ctx context.Context) io.WriteCloser {
+		writer := o.obj.NewWriter(ctx)
+		writer.ChunkSize = 0
+		return writer
+	})
 }
 
 func (o GoogleCloudStorageObject) GetReader(ctx context.Context) (io.ReadCloser, error) {
-	return o.obj.NewReader(ctx)
+	return traceRead(ctx, o.obj.ObjectName(), func(ctx context.Context) (io.ReadCloser, error) {
+		return o.obj.NewReader(ctx)
+	})
 }
 
 func (o GoogleCloudStorageObject) GetCreatedAt(ctx context.Context) (*time.Time, error) {
@@ -90,6 +110,14 @@ func (o GoogleCloudStorageObject) GetCreatedAt(ctx context.Context) (*time.Time,
 	return &attrs.Created, nil
 }
 
+func (o GoogleCloudStorageObject) Delete(ctx context.Context) error {
+	err := o.obj.Delete(ctx)
+	if err != nil {
+		o.logger.Error().Err(err).Msg("error deleting object")
+	}
+	return err
+}
+
 func (o GoogleCloudStorageObject) GetSize(ctx context.Context) (*int64, error) {
 	attrs, err := o.obj.Attrs(ctx)
 	if err != nil {
@@ -127,6 +155,31 @@ func NewS3Storage() Storage {
 	}
 }
 
+// Ping requests object, missing object means bucket is reachable; without s3:ListBucket permission
+// S3 answers access denied instead of no such key, it is accepted as well because credentials were
+// verified, invalid credentials are answered with own error codes
+func (s S3Storage) Ping(ctx context.Context) error {
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(pingObject),
+	})
+	if err != nil {
+		return s3PingError(err)
+	}
+	return out.Body.Close()
+}
+
+// s3PingError returns nil when error means that bucket answered for ping object
+func s3PingError(err error) error {
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case s3.ErrCodeNoSuchKey, "AccessDenied":
+			return nil
+		}
+	}
+	return err
+}
+
 func (s S3Storage) GetObject(name string) StorageObject {
 	return S3StorageObject{
 		s,
@@ -136,6 +189,10 @@ func (s S3Storage) GetObject(name string) StorageObject {
 }
 
 func (o S3StorageObject) GetWriter(ctx context.Context) io.WriteCloser {
+	return traceWrite(ctx, o.name, o.getWriter)
+}
+
+func (o S3StorageObject) getWriter(ctx context.Context) io.WriteCloser {
 	r, w := io.Pipe()
 	errorGroup := &errgroup.Group{}
 	errorGroup.Go(func() error {
@@ -161,6 +218,10 @@ func (o S3StorageObject) GetWriter(ctx context.Context) io.WriteCloser {
 }
 
 func (o S3StorageObject) GetReader(ctx context.Context) (io.ReadCloser, error) {
+	return traceRead(ctx, o.name, o.getReader)
+}
+
+func (o S3StorageObject) getReader(ctx context.Context) (io.ReadCloser, error) {
 	output, err := o.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(o.bucketName),
 		Key:    aws.String(o.name),
@@ -195,6 +256,17 @@ func (o S3StorageObject) GetCreatedAt(ctx context.Context) (*time.Time, error) {
 	return out.LastModified, nil
 }
 
+func (o S3StorageObject) Delete(ctx context.Context) error {
+	_, err := o.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(o.bucketName),
+		Key:    aws.String(o.name),
+	})
+	if err != nil {
+		o.logger.Err(err).Msg("error while deleting object")
+	}
+	return err
+}
+
 func (o S3StorageObject) CopyFromS3(ctx context.Context, source string) error {
 	u, err := url.Parse(source)
 	if err != nil {
@@ -0,0 +1,24 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3PingError(t *testing.T) {
+	require.NoError(t, s3PingError(awserr.New(s3.ErrCodeNoSuchKey, "", nil)))
+	// missing object without s3:ListBucket permission
+	require.NoError(t, s3PingError(awserr.New("AccessDenied", "", nil)))
+	for _, err := range []error{
+		awserr.New("InvalidAccessKeyId", "", nil),
+		awserr.New("SignatureDoesNotMatch", "", nil),
+		awserr.New(s3.ErrCodeNoSuchBucket, "", nil),
+		errors.New("connection refused"),
+	} {
+		require.Error(t, s3PingError(err))
+	}
+}
@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"context"
+	"io"
+
+	"dekart/src/server/metrics"
+	"dekart/src/server/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceRead opens object reader in span which ends when reader is closed; bytes read are counted in metrics
+func traceRead(ctx context.Context, object string, open func(ctx context.Context) (io.ReadCloser, error)) (io.ReadCloser, error) {
+	ctx, span := tracing.Start(ctx, "storage.Read", attribute.String("storage.object", object))
+	reader, err := open(ctx)
+	if err != nil {
+		tracing.End(span, err)
+		return nil, err
+	}
+	return &tracedReader{ReadCloser: metrics.CountRead(reader), span: span}, nil
+}
+
+// traceWrite opens object writer in span which ends when writer is closed; bytes written are counted in metrics
+func traceWrite(ctx context.Context, object string, open func(ctx context.Context) io.WriteCloser) io.WriteCloser {
+	ctx, span := tracing.Start(ctx, "storage.Write", attribute.String("storage.object", object))
+	return &tracedWriter{WriteCloser: metrics.CountWrite(open(ctx)), span: span}
+}
+
+type tracedReader struct {
+	io.ReadCloser
+	span  trace.Span
+	bytes int64
+}
+
+func (r *tracedReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.bytes += int64(n)
+	return n, err
+}
+
+func (r *tracedReader) Close() error {
+	err := r.ReadCloser.Close()
+	r.span.SetAttributes(attribute.Int64("storage.bytes", r.bytes))
+	tracing.End(r.span, err)
+	return err
+}
+
+type tracedWriter struct {
+	io.WriteCloser
+	span  trace.Span
+	bytes int64
+	err   error
+}
+
+func (w *tracedWriter) Write(p []byte) (int, error) {
+	n, err := w.WriteCloser.Write(p)
+	w.bytes += int64(n)
+	if err != nil && w.err == nil {
+		w.err = err
+	}
+	return n, err
+}
+
+func (w *tracedWriter) Close() error {
+	err := w.WriteCloser.Close()
+	if err == nil {
+		err = w.err
+	}
+	w.span.SetAttributes(attribute.Int64("storage.bytes", w.bytes))
+	tracing.End(w.span, err)
+	return err
+}
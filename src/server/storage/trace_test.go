@@ -0,0 +1,41 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"dekart/src/server/tracing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+)
+
+func TestLocalStorageSpans(t *testing.T) {
+	var spans bytes.Buffer
+	provider, err := tracing.NewStdoutProvider(&spans)
+	require.NoError(t, err)
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	ctx := context.Background()
+	s, err := NewLocalStorageInDir(filepath.Join(t.TempDir(), "bucket"))
+	require.NoError(t, err)
+	obj := s.GetObject("traced.csv")
+	writer := obj.GetWriter(ctx)
+	_, err = writer.Write([]byte("a,b\n"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	require.Equal(t, "a,b\n", readObject(t, obj))
+
+	_, err = s.GetObject("missing.csv").GetReader(ctx)
+	require.Error(t, err)
+
+	require.NoError(t, provider.Shutdown(ctx))
+	require.Contains(t, spans.String(), `"Name":"storage.Write"`)
+	require.Contains(t, spans.String(), `"Name":"storage.Read"`)
+	require.Contains(t, spans.String(), `"Key":"storage.bytes","Value":{"Type":"INT64","Value":4}`)
+	require.Contains(t, spans.String(), `"Code":"Error"`)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/golang-jwt/jwt"
@@ -15,7 +16,8 @@ import (
 
 // Claims stores user detail received from request
 type Claims struct {
-	Email string
+	Email      string
+	APITokenID string // set when request is authenticated with API token
 }
 
 // ContextKey type
@@ -31,6 +33,35 @@ type ClaimsCheck struct {
 	devClaimsEmail    string
 	region            string
 	publicKeys        *sync.Map
+	tokenResolver     TokenResolver
+	oidc              *oidcValidator
+}
+
+// APITokenPrefix distinguishes dekart API tokens from other bearer tokens
+const APITokenPrefix = "dekart_"
+
+// TokenResolver returns claims of API token owner, nil when token is unknown or revoked
+type TokenResolver interface {
+	ResolveAPIToken(ctx context.Context, token string) (*Claims, error)
+}
+
+// WithTokenResolver enables API tokens in Authorization: Bearer header
+func (c ClaimsCheck) WithTokenResolver(tokenResolver TokenResolver) ClaimsCheck {
+	c.tokenResolver = tokenResolver
+	return c
+}
+
+// getAPIToken from Authorization header
+func getAPIToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return ""
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+	if !strings.HasPrefix(token, APITokenPrefix) {
+		return ""
+	}
+	return token
 }
 
 // NewClaimsCheck creates Context
@@ -59,7 +90,23 @@ func NewClaimsCheck(audience string, requireIAP bool, requireAmazonOIDC bool, re
 		devClaimsEmail,
 		region,
 		&sync.Map{},
+		nil,
+		nil,
+	}
+}
+
+// WithOIDC requires JWT from generic OIDC provider
+func (c ClaimsCheck) WithOIDC(config OIDCConfig) ClaimsCheck {
+	if c.requireIAP || c.requireAmazonOIDC {
+		log.Fatal().Msg("DEKART_REQUIRE_OIDC, DEKART_REQUIRE_IAP and DEKART_REQUIRE_AMAZON_OIDC are mutually exclusive")
 	}
+	oidc, err := newOIDCValidator(config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Cannot configure OIDC")
+	}
+	log.Info().Str("issuer", config.Issuer).Msg("Dekart configured to require OIDC")
+	c.oidc = oidc
+	return c
 }
 
 // UnknownEmail is set as claims email when auth is not required
@@ -69,7 +116,14 @@ const UnknownEmail = "UNKNOWN_EMAIL"
 func (c ClaimsCheck) GetContext(r *http.Request) context.Context {
 	ctx := r.Context()
 	var claims *Claims
-	if c.devClaimsEmail != "" {
+	if token := getAPIToken(r); token != "" && c.tokenResolver != nil {
+		// invalid token is not authenticated by other methods
+		var err error
+		claims, err = c.tokenResolver.ResolveAPIToken(ctx, token)
+		if err != nil {
+			log.Err(err).Msg("Error resolving API token")
+		}
+	} else if c.devClaimsEmail != "" {
 		claims = &Claims{
 			Email: c.devClaimsEmail,
 		}
@@ -77,6 +131,8 @@ func (c ClaimsCheck) GetContext(r *http.Request) context.Context {
 		claims = c.validateJWTFromAppEngine(ctx, r.Header.Get("X-Goog-IAP-JWT-Assertion"))
 	} else if c.requireAmazonOIDC {
 		claims = c.validateJWTFromAmazonOIDC(ctx, r.Header.Get("x-amzn-oidc-data"))
+	} else if c.oidc != nil {
+		claims = c.validateJWTFromOIDC(ctx, r)
 	} else {
 		claims = &Claims{
 			Email: UnknownEmail,
@@ -0,0 +1,277 @@
+package user
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/rs/zerolog/log"
+)
+
+// OIDCConfig configures validation of JWT issued by any OIDC provider, for example Keycloak behind auth proxy
+type OIDCConfig struct {
+	Issuer     string // expected iss claim, not checked when empty
+	JWKSURL    string // provider keys, like https://keycloak/realms/dekart/protocol/openid-connect/certs
+	KeyFile    string // local JWKS or PEM public key, used instead of JWKSURL
+	Audience   string // expected aud claim, not checked when empty
+	Header     string // header with token, Bearer prefix is optional; Authorization by default
+	EmailClaim string // claim with user email; email by default
+}
+
+// keys are re-fetched on unknown kid not more often than this
+const jwksRefreshInterval = time.Minute
+
+type oidcValidator struct {
+	config    OIDCConfig
+	client    *http.Client
+	mutex     sync.Mutex
+	keys      map[string]interface{}
+	fetchedAt time.Time
+}
+
+func newOIDCValidator(config OIDCConfig) (*oidcValidator, error) {
+	if config.Header == "" {
+		config.Header = "Authorization"
+	}
+	if config.EmailClaim == "" {
+		config.EmailClaim = "email"
+	}
+	v := &oidcValidator{
+		config: config,
+		client: &http.Client{Timeout: 10 * time.Second},
+		keys:   make(map[string]interface{}),
+	}
+	switch {
+	case config.KeyFile != "":
+		data, err := ioutil.ReadFile(config.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		v.keys, err = parseKeys(data)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %s: %w", config.KeyFile, err)
+		}
+	case config.JWKSURL != "":
+		// keys are fetched on first request, so provider may start after dekart
+	default:
+		return nil, fmt.Errorf("OIDC requires JWKS URL or key file")
+	}
+	return v, nil
+}
+
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func (k jwk) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+// parseKeys from JWKS or PEM; PEM key has empty kid and is used for any token
+func parseKeys(data []byte) (map[string]interface{}, error) {
+	keys := make(map[string]interface{})
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "{") {
+		var set jwks
+		if err := json.Unmarshal(data, &set); err != nil {
+			return nil, err
+		}
+		for _, k := range set.Keys {
+			if k.Use != "" && k.Use != "sig" {
+				continue
+			}
+			key, err := k.publicKey()
+			if err != nil {
+				log.Warn().Err(err).Str("kid", k.Kid).Msg("Skipping JWKS key")
+				continue
+			}
+			keys[k.Kid] = key
+		}
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("no signing keys in JWKS")
+		}
+		return keys, nil
+	}
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(data); err == nil {
+		keys[""] = key
+		return keys, nil
+	}
+	key, err := jwt.ParseECPublicKeyFromPEM(data)
+	if err != nil {
+		return nil, fmt.Errorf("key is neither JWKS nor RSA or EC public key PEM")
+	}
+	keys[""] = key
+	return keys, nil
+}
+
+func (v *oidcValidator) fetchKeys(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.config.JWKSURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error fetch %s, status %d", v.config.JWKSURL, resp.StatusCode)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	keys, err := parseKeys(data)
+	if err != nil {
+		return err
+	}
+	v.keys = keys
+	return nil
+}
+
+// getKey by kid, JWKS is re-fetched when key is unknown to support key rotation
+func (v *oidcValidator) getKey(ctx context.Context, kid string) (interface{}, error) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+	if key, ok := v.keys[""]; ok {
+		return key, nil
+	}
+	if v.config.JWKSURL == "" || time.Since(v.fetchedAt) < jwksRefreshInterval {
+		return nil, fmt.Errorf("unknown key id %s", kid)
+	}
+	v.fetchedAt = time.Now()
+	if err := v.fetchKeys(ctx); err != nil {
+		return nil, err
+	}
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key id %s", kid)
+}
+
+func hasAudience(claims jwt.MapClaims, audience string) bool {
+	switch aud := claims["aud"].(type) {
+	case string:
+		return aud == audience
+	case []interface{}:
+		for _, a := range aud {
+			if s, ok := a.(string); ok && s == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// validate token and return claims with email
+func (v *oidcValidator) validate(ctx context.Context, tokenString string) (*Claims, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS, *jwt.SigningMethodECDSA:
+		default:
+			return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+		}
+		kid, _ := token.Header["kid"].(string)
+		return v.getKey(ctx, kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+	mapClaims := token.Claims.(jwt.MapClaims)
+	if v.config.Issuer != "" && !mapClaims.VerifyIssuer(v.config.Issuer, true) {
+		return nil, fmt.Errorf("unexpected issuer %v", mapClaims["iss"])
+	}
+	if v.config.Audience != "" && !hasAudience(mapClaims, v.config.Audience) {
+		return nil, fmt.Errorf("unexpected audience %v", mapClaims["aud"])
+	}
+	email, ok := mapClaims[v.config.EmailClaim].(string)
+	if !ok || email == "" {
+		return nil, fmt.Errorf("no %s in claims", v.config.EmailClaim)
+	}
+	return &Claims{
+		Email: email,
+	}, nil
+}
+
+// validateJWTFromOIDC validates token from configured header
+func (c ClaimsCheck) validateJWTFromOIDC(ctx context.Context, r *http.Request) *Claims {
+	header := strings.TrimSpace(r.Header.Get(c.oidc.config.Header))
+	if header == "" {
+		return nil
+	}
+	if len(header) > 7 && strings.EqualFold(header[:7], "Bearer ") {
+		header = strings.TrimSpace(header[7:])
+	}
+	claims, err := c.oidc.validate(ctx, header)
+	if err != nil {
+		log.Warn().Err(err).Msg("Error validating OIDC JWT")
+		return nil
+	}
+	return claims
+}
@@ -0,0 +1,201 @@
+package user
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeBigInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+// jwksServer serves public key of rsaKey as JWKS, like OIDC provider certs endpoint
+func jwksServer(t *testing.T, kid string, rsaKey *rsa.PrivateKey) (*httptest.Server, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{
+				{
+					"kid": kid,
+					"kty": "RSA",
+					"use": "sig",
+					"n":   encodeBigInt(rsaKey.N),
+					"e":   encodeBigInt(big.NewInt(int64(rsaKey.E))),
+				},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	require.NoError(t, err)
+	return s
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":   "https://keycloak.example.com/realms/dekart",
+		"aud":   []string{"account", "dekart"},
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"email": "user@example.com",
+	}
+}
+
+func TestOIDCWithJWKS(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server, requests := jwksServer(t, "key-1", rsaKey)
+	check := ClaimsCheck{}.WithOIDC(OIDCConfig{
+		Issuer:   "https://keycloak.example.com/realms/dekart",
+		JWKSURL:  server.URL,
+		Audience: "dekart",
+	})
+
+	getClaims := func(header string) *Claims {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		if header != "" {
+			r.Header.Set("Authorization", header)
+		}
+		return GetClaims(check.GetContext(r))
+	}
+
+	t.Run("valid token", func(t *testing.T) {
+		token := signToken(t, jwt.SigningMethodRS256, "key-1", rsaKey, validClaims())
+		claims := getClaims("Bearer " + token)
+		require.NotNil(t, claims)
+		require.Equal(t, "user@example.com", claims.Email)
+		// token without Bearer prefix
+		require.NotNil(t, getClaims(token))
+		require.Equal(t, 1, *requests, "keys are cached")
+	})
+
+	t.Run("invalid tokens", func(t *testing.T) {
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		tests := []struct {
+			name   string
+			update func(claims jwt.MapClaims)
+			key    *rsa.PrivateKey
+			kid    string
+		}{
+			{name: "wrong issuer", update: func(c jwt.MapClaims) { c["iss"] = "https://other.example.com" }},
+			{name: "wrong audience", update: func(c jwt.MapClaims) { c["aud"] = "other" }},
+			{name: "expired", update: func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() }},
+			{name: "no email", update: func(c jwt.MapClaims) { delete(c, "email") }},
+			{name: "wrong signature", key: otherKey},
+			{name: "unknown kid", kid: "key-2"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				claims := validClaims()
+				if tt.update != nil {
+					tt.update(claims)
+				}
+				key := rsaKey
+				if tt.key != nil {
+					key = tt.key
+				}
+				kid := "key-1"
+				if tt.kid != "" {
+					kid = tt.kid
+				}
+				require.Nil(t, getClaims("Bearer "+signToken(t, jwt.SigningMethodRS256, kid, key, claims)))
+			})
+		}
+		require.Nil(t, getClaims(""))
+		require.Nil(t, getClaims("Bearer not-a-jwt"))
+		unsigned := signToken(t, jwt.SigningMethodNone, "key-1", jwt.UnsafeAllowNoneSignatureType, validClaims())
+		require.Nil(t, getClaims("Bearer "+unsigned))
+	})
+}
+
+func TestOIDCWithKeyFile(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+
+	check := ClaimsCheck{}.WithOIDC(OIDCConfig{
+		KeyFile:    keyFile,
+		Header:     "X-Auth-Request-Access-Token",
+		EmailClaim: "preferred_username",
+	})
+	token := signToken(t, jwt.SigningMethodES256, "", ecKey, jwt.MapClaims{
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"preferred_username": "admin@example.com",
+	})
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-Auth-Request-Access-Token", token)
+	claims := GetClaims(check.GetContext(r))
+	require.NotNil(t, claims)
+	require.Equal(t, "admin@example.com", claims.Email)
+
+	// token in other header is ignored
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	require.Nil(t, GetClaims(check.GetContext(r)))
+}
+
+func TestNewOIDCValidatorErrors(t *testing.T) {
+	_, err := newOIDCValidator(OIDCConfig{})
+	require.Error(t, err)
+	keyFile := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(keyFile, []byte("not a key"), 0600))
+	_, err = newOIDCValidator(OIDCConfig{KeyFile: keyFile})
+	require.Error(t, err)
+	_, err = newOIDCValidator(OIDCConfig{KeyFile: filepath.Join(t.TempDir(), "missing.pem")})
+	require.Error(t, err)
+}
+
+type staticResolver map[string]string
+
+func (r staticResolver) ResolveAPIToken(ctx context.Context, token string) (*Claims, error) {
+	if email, ok := r[token]; ok {
+		return &Claims{Email: email, APITokenID: token}, nil
+	}
+	return nil, nil
+}
+
+func TestAPITokenTakesPrecedenceOverOIDC(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server, _ := jwksServer(t, "key-1", rsaKey)
+	check := ClaimsCheck{}.WithOIDC(OIDCConfig{JWKSURL: server.URL}).WithTokenResolver(staticResolver{
+		"dekart_script": "ci@example.com",
+	})
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer dekart_script")
+	claims := GetClaims(check.GetContext(r))
+	require.NotNil(t, claims)
+	require.Equal(t, "ci@example.com", claims.Email)
+
+	r.Header.Set("Authorization", "Bearer dekart_revoked")
+	require.Nil(t, GetClaims(check.GetContext(r)))
+}